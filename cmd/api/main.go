@@ -3,66 +3,338 @@ package main
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/graphql"
+	grpcServer "github.com/fesbarbosa/melivendas-api/internal/adapters/input/grpc/server"
 	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/handlers"
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/middleware"
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/openapi"
 	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/routes"
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/output/authz"
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/output/cache"
 	"github.com/fesbarbosa/melivendas-api/internal/adapters/output/db"
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/output/events"
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/output/metrics"
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/output/ratelimit"
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/output/tracing"
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/output/webhook"
 	"github.com/fesbarbosa/melivendas-api/internal/config"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
 	"github.com/fesbarbosa/melivendas-api/internal/core/services"
+	"github.com/fesbarbosa/melivendas-api/pkg/logger"
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+	"google.golang.org/grpc"
 )
 
 func main() {
 
 	cfg := config.NewConfig()
 
+	appLogger := logger.New(os.Stdout, cfg.Log.Level, cfg.Log.Format)
+	slog.SetDefault(appLogger)
+
+	if err := cfg.Validate(); err != nil {
+		fatal("Configuração inválida", err)
+	}
+
+	tracerProvider, err := tracing.NewTracerProvider(context.Background(), &cfg.Tracing)
+	if err != nil {
+		fatal("Falha ao inicializar rastreamento", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := tracerProvider.Shutdown(ctx); err != nil {
+			slog.Error("Falha ao finalizar rastreamento", "error", err)
+		}
+	}()
+
 	database, err := db.InitDB(&cfg.Database)
 	if err != nil {
-		log.Fatalf("Falha ao inicializar banco de dados: %v", err)
+		fatal("Falha ao inicializar banco de dados", err)
 	}
 	defer database.Close()
 
-	itemRepository := db.NewItemRepository(database)
+	replicaSet, err := db.InitReplicaSet(database, &cfg.Database)
+	if err != nil {
+		fatal("Falha ao inicializar réplicas de leitura", err)
+	}
+	defer replicaSet.Close()
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		collectors.NewDBStatsCollector(database.DB, cfg.Database.DBName),
+		metrics.NewItemStatsCollector(db.NewItemStatsRepository(replicaSet)),
+	)
+
+	var itemRepository output.ItemRepository = metrics.NewInstrumentedItemRepository(
+		tracing.NewTracedItemRepository(db.NewItemRepository(database, replicaSet)),
+		registry,
+	)
+	if cfg.Cache.Enabled {
+		itemRepository = cache.NewCachedItemRepository(itemRepository, cfg.Cache.Size, cfg.Cache.TTL, registry)
+	}
+
+	var policy output.AuthorizationPolicy = authz.NewRolePolicy()
+	if !cfg.Auth.Enabled {
+		policy = authz.NewAllowAllPolicy()
+	}
+
+	itemChangeHub := services.NewItemChangeHub(policy, cfg.Stream.ReplayBuffer)
+
+	transactor := db.NewTxManager(database)
+
+	itemService := services.NewItemService(itemRepository, transactor, policy, itemChangeHub)
+
+	promotionRepository := db.NewPromotionRepository(database)
+
+	pricingService := services.NewPricingService(promotionRepository)
+
+	promotionService := services.NewPromotionService(promotionRepository, policy)
+
+	promotionHandler := handlers.NewPromotionHandler(promotionService)
+
+	itemHandler := handlers.NewItemHandler(itemService, pricingService, cfg.HTTPCache.CacheControl)
+
+	itemStreamHandler := handlers.NewItemStreamHandler(itemChangeHub, cfg.Stream.Heartbeat)
+
+	priceRepository := db.NewPriceRepository(database)
+
+	priceService := services.NewPriceService(itemRepository, priceRepository, transactor, policy, itemChangeHub)
+
+	priceHandler := handlers.NewPriceHandler(priceService)
+
+	graphQLExecutor, err := graphql.NewExecutor(itemService)
+	if err != nil {
+		fatal("Falha ao montar schema GraphQL", err)
+	}
+
+	graphQLHandler := handlers.NewGraphQLHandler(graphQLExecutor)
+
+	apiKeyRepository := db.NewAPIKeyRepository(database)
+
+	apiKeyService := services.NewAPIKeyService(apiKeyRepository, transactor, policy)
+
+	apiKeyHandler := handlers.NewAPIKeyHandler(apiKeyService)
+
+	webhookRepository := db.NewWebhookRepository(database)
+
+	webhookService := services.NewWebhookService(webhookRepository, policy, cfg.Webhooks.AllowInternalTargets)
+
+	webhookHandler := handlers.NewWebhookHandler(webhookService)
 
-	itemService := services.NewItemService(itemRepository)
+	hostname, _ := os.Hostname()
+	workerID := fmt.Sprintf("%s-%d", hostname, os.Getpid())
 
-	itemHandler := handlers.NewItemHandler(itemService)
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
 
-	router := gin.Default()
+	publishers := []output.EventPublisher{events.NewLogPublisher(appLogger)}
+	if cfg.Webhooks.Enabled {
+		publishers = append(publishers, services.NewWebhookDispatcher(webhookRepository))
 
-	router.Use(gin.Recovery())
-	router.Use(gin.Logger())
+		webhookWorker := services.NewWebhookDeliveryWorker(
+			webhookRepository,
+			webhook.NewHTTPSender(cfg.Webhooks.Timeout, cfg.Webhooks.AllowInternalTargets),
+			workerID,
+			cfg.Webhooks.BatchSize,
+			cfg.Webhooks.PollInterval,
+			cfg.Webhooks.Lease,
+			cfg.Webhooks.MaxAttempts,
+			cfg.Webhooks.BaseBackoff,
+			cfg.Webhooks.DisableAfter,
+		)
+		go webhookWorker.Run(workersCtx)
+	}
+
+	if cfg.Outbox.Enabled {
+		outboxRelay := services.NewOutboxRelay(
+			db.NewOutboxRepository(database),
+			events.NewMultiPublisher(publishers...),
+			workerID,
+			cfg.Outbox.BatchSize,
+			cfg.Outbox.PollInterval,
+			cfg.Outbox.Lease,
+		)
+		go outboxRelay.Run(workersCtx)
+	}
+
+	if cfg.Prices.Enabled {
+		priceScheduler := services.NewPriceScheduler(
+			itemRepository,
+			priceRepository,
+			transactor,
+			itemChangeHub,
+			workerID,
+			cfg.Prices.BatchSize,
+			cfg.Prices.PollInterval,
+			cfg.Prices.Lease,
+		)
+		go priceScheduler.Run(workersCtx)
+	}
 
-	routes.RegisterItemRoutes(router, itemHandler)
+	go replicaSet.Run(workersCtx, cfg.Database.ReplicaCheckInterval)
+
+	authenticator, err := middleware.NewJWTAuthenticator(&cfg.Auth)
+	if err != nil {
+		fatal("Falha ao configurar autenticação", err)
+	}
+
+	readinessChecks := []handlers.ReadinessCheck{
+		{Name: "database", Check: database.PingContext},
+	}
+
+	apiDocument := openapi.Build()
+
+	router := gin.New()
+	router.HandleMethodNotAllowed = true
+	if err := router.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		fatal("Falha ao configurar proxies confiáveis", err)
+	}
+	router.NoRoute(middleware.NoRoute)
+	router.NoMethod(middleware.NoMethod)
+
+	router.Use(middleware.RequestID())
+	router.Use(middleware.Language())
+	if cfg.Errors.ProblemJSON {
+		router.Use(middleware.ProblemDetails())
+	}
+	router.Use(otelgin.Middleware(cfg.Tracing.ServiceName))
+	router.Use(middleware.RequestLogger(appLogger))
+	router.Use(middleware.Recovery())
+	router.Use(middleware.NewHTTPMetrics(registry).Middleware())
+	var rateLimiter *middleware.RateLimiter
+	if cfg.RateLimit.Enabled {
+		var store output.RateLimitStore
+		switch cfg.RateLimit.Store {
+		case "redis":
+			redisClient := redis.NewClient(&redis.Options{Addr: cfg.RateLimit.RedisAddr})
+			defer redisClient.Close()
+			readinessChecks = append(readinessChecks, handlers.ReadinessCheck{
+				Name: "redis",
+				Check: func(ctx context.Context) error {
+					return redisClient.Ping(ctx).Err()
+				},
+			})
+			store = ratelimit.NewRedisStore(redisClient, "melivendas:ratelimit:")
+		default:
+			store = ratelimit.NewMemoryStore()
+		}
+
+		rateLimiter = middleware.NewRateLimiter(
+			store,
+			output.RateLimit{Rate: cfg.RateLimit.ReadRate, Burst: cfg.RateLimit.ReadBurst},
+			output.RateLimit{Rate: cfg.RateLimit.WriteRate, Burst: cfg.RateLimit.WriteBurst},
+			output.RateLimit{Rate: cfg.RateLimit.IPRate, Burst: cfg.RateLimit.IPBurst},
+		)
+		router.Use(rateLimiter.ClientIPMiddleware())
+	}
+
+	router.Use(middleware.APIKeyAuth(apiKeyService))
+	router.Use(authenticator.Middleware())
+	if rateLimiter != nil {
+		router.Use(rateLimiter.Middleware())
+	}
+
+	if cfg.OpenAPI.ValidateRequests {
+		validateResponses := cfg.OpenAPI.ValidateResponses || gin.Mode() == gin.TestMode
+		router.Use(middleware.NewOpenAPIValidator(apiDocument, validateResponses).Middleware())
+	}
+
+	healthHandler := handlers.NewHealthHandler(readinessChecks...)
+
+	routes.Register(router, routes.Handlers{
+		Health:     healthHandler,
+		Item:       itemHandler,
+		ItemStream: itemStreamHandler,
+		Price:      priceHandler,
+		Promotion:  promotionHandler,
+		APIKey:     apiKeyHandler,
+		Webhook:    webhookHandler,
+		GraphQL:    graphQLHandler,
+		Metrics:    promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
+		OpenAPI:    apiDocument,
+	})
 
 	srv := &http.Server{
 		Addr:    fmt.Sprintf(":%s", cfg.Server.Port),
 		Handler: router,
 	}
+	srv.RegisterOnShutdown(itemStreamHandler.Shutdown)
 
 	go func() {
-		log.Printf("Servidor escutando na porta %s", cfg.Server.Port)
+		slog.Info("Servidor escutando", "port", cfg.Server.Port)
 		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("Falha ao iniciar servidor: %v", err)
+			fatal("Falha ao iniciar servidor", err)
 		}
 	}()
 
+	var rpcServer *grpc.Server
+	if cfg.GRPC.Enabled {
+		listener, err := net.Listen("tcp", fmt.Sprintf(":%s", cfg.GRPC.Port))
+		if err != nil {
+			fatal("Falha ao abrir porta gRPC", err)
+		}
+
+		rpcServer = grpcServer.New(itemService, apiKeyService, authenticator, cfg.GRPC.Reflection)
+
+		go func() {
+			slog.Info("Servidor gRPC escutando", "port", cfg.GRPC.Port)
+			if err := rpcServer.Serve(listener); err != nil && err != grpc.ErrServerStopped {
+				fatal("Falha ao iniciar servidor gRPC", err)
+			}
+		}()
+	}
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
-	log.Println("Desligando servidor...")
+	slog.Info("Desligando servidor...")
+
+	healthHandler.SetShuttingDown()
+	slog.Info("Aguardando drenagem do tráfego", "drain", cfg.Server.ShutdownDrain)
+	time.Sleep(cfg.Server.ShutdownDrain)
 
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
 	defer cancel()
 	if err := srv.Shutdown(ctx); err != nil {
-		log.Fatalf("Servidor forçado a desligar: %v", err)
+		fatal("Servidor forçado a desligar", err)
 	}
 
-	log.Println("Servidor encerrado com sucesso")
+	if rpcServer != nil {
+		stopped := make(chan struct{})
+		go func() {
+			rpcServer.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			rpcServer.Stop()
+		}
+	}
+
+	stopWorkers()
+
+	slog.Info("Servidor encerrado com sucesso")
+}
+
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
 }
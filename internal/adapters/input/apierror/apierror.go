@@ -0,0 +1,77 @@
+package apierror
+
+import (
+	"errors"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/services"
+	apiErrors "github.com/fesbarbosa/melivendas-api/pkg/errors"
+)
+
+type mapping struct {
+	target error
+	kind   error
+	code   string
+}
+
+var mappings = []mapping{
+	{services.ErrItemNotFound, apiErrors.ErrNotFound, "ITEM_NAO_ENCONTRADO"},
+	{services.ErrAPIKeyNotFound, apiErrors.ErrNotFound, "CHAVE_API_NAO_ENCONTRADA"},
+	{services.ErrWebhookNotFound, apiErrors.ErrNotFound, "WEBHOOK_NAO_ENCONTRADO"},
+	{services.ErrWebhookDeliveryNotFound, apiErrors.ErrNotFound, "ENTREGA_WEBHOOK_NAO_ENCONTRADA"},
+	{services.ErrScheduledPriceNotFound, apiErrors.ErrNotFound, "AGENDAMENTO_PRECO_NAO_ENCONTRADO"},
+	{services.ErrPromotionNotFound, apiErrors.ErrNotFound, "PROMOCAO_NAO_ENCONTRADA"},
+	{services.ErrDuplicateCode, apiErrors.ErrConflict, "CODIGO_ITEM_DUPLICADO"},
+	{services.ErrAPIKeyAlreadyRotated, apiErrors.ErrConflict, "CHAVE_API_JA_ROTACIONADA"},
+	{services.ErrMissingItemFields, apiErrors.ErrBadRequest, "ITEM_CAMPOS_OBRIGATORIOS"},
+	{services.ErrInvalidPrice, apiErrors.ErrBadRequest, "PRECO_INVALIDO"},
+	{services.ErrNegativeStock, apiErrors.ErrBadRequest, "ESTOQUE_NEGATIVO"},
+	{services.ErrInvalidData, apiErrors.ErrBadRequest, "DADOS_ITEM_INVALIDOS"},
+	{services.ErrMissingAPIKeyName, apiErrors.ErrBadRequest, "CHAVE_API_NOME_OBRIGATORIO"},
+	{services.ErrMissingAPIKeyScopes, apiErrors.ErrBadRequest, "CHAVE_API_ESCOPO_OBRIGATORIO"},
+	{services.ErrUnknownScope, apiErrors.ErrBadRequest, "CHAVE_API_ESCOPO_DESCONHECIDO"},
+	{services.ErrExpirationInPast, apiErrors.ErrBadRequest, "CHAVE_API_EXPIRACAO_INVALIDA"},
+	{services.ErrNegativeOverlap, apiErrors.ErrBadRequest, "CHAVE_API_SOBREPOSICAO_NEGATIVA"},
+	{services.ErrAPIKeyNotActive, apiErrors.ErrBadRequest, "CHAVE_API_INATIVA"},
+	{services.ErrInvalidAPIKeyData, apiErrors.ErrBadRequest, "DADOS_CHAVE_API_INVALIDOS"},
+	{services.ErrInvalidWebhookURL, apiErrors.ErrBadRequest, "WEBHOOK_URL_INVALIDA"},
+	{services.ErrWebhookURLNotAllowed, apiErrors.ErrBadRequest, "WEBHOOK_URL_NAO_PERMITIDA"},
+	{services.ErrMissingEventTypes, apiErrors.ErrBadRequest, "WEBHOOK_EVENTOS_OBRIGATORIOS"},
+	{services.ErrUnknownEventType, apiErrors.ErrBadRequest, "WEBHOOK_EVENTO_DESCONHECIDO"},
+	{services.ErrSubscriptionDisabled, apiErrors.ErrBadRequest, "WEBHOOK_DESATIVADO"},
+	{services.ErrInvalidWebhookData, apiErrors.ErrBadRequest, "DADOS_WEBHOOK_INVALIDOS"},
+	{services.ErrScheduleStartInPast, apiErrors.ErrBadRequest, "AGENDAMENTO_PRECO_INICIO_INVALIDO"},
+	{services.ErrScheduleEndBeforeStart, apiErrors.ErrBadRequest, "AGENDAMENTO_PRECO_TERMINO_INVALIDO"},
+	{services.ErrScheduleNotCancellable, apiErrors.ErrBadRequest, "AGENDAMENTO_PRECO_NAO_CANCELAVEL"},
+	{services.ErrInvalidScheduleData, apiErrors.ErrBadRequest, "DADOS_AGENDAMENTO_PRECO_INVALIDOS"},
+	{services.ErrScheduleOverlap, apiErrors.ErrConflict, "AGENDAMENTO_PRECO_SOBREPOSTO"},
+	{services.ErrMissingPromotionName, apiErrors.ErrBadRequest, "PROMOCAO_NOME_OBRIGATORIO"},
+	{services.ErrInvalidDiscountType, apiErrors.ErrBadRequest, "PROMOCAO_TIPO_DESCONTO_INVALIDO"},
+	{services.ErrInvalidDiscountValue, apiErrors.ErrBadRequest, "PROMOCAO_VALOR_DESCONTO_INVALIDO"},
+	{services.ErrPromotionWithoutTargets, apiErrors.ErrBadRequest, "PROMOCAO_SEM_ALVOS"},
+	{services.ErrPromotionEndBeforeStart, apiErrors.ErrBadRequest, "PROMOCAO_TERMINO_INVALIDO"},
+	{services.ErrPromotionInactive, apiErrors.ErrBadRequest, "PROMOCAO_INATIVA"},
+	{services.ErrInvalidPromotionData, apiErrors.ErrBadRequest, "DADOS_PROMOCAO_INVALIDOS"},
+	{services.ErrInvalidAPIKey, apiErrors.ErrUnauthorized, "CHAVE_API_INVALIDA"},
+	{services.ErrForbidden, apiErrors.ErrForbidden, "ACESSO_NEGADO"},
+	{domain.ErrMissingSeller, apiErrors.ErrUnauthorized, "VENDEDOR_AUSENTE"},
+}
+
+func From(err error) *apiErrors.APIError {
+	var apiErr *apiErrors.APIError
+	if errors.As(err, &apiErr) {
+		copied := *apiErr
+		copied.Campos = append([]apiErrors.FieldError(nil), apiErr.Campos...)
+		return &copied
+	}
+
+	for _, m := range mappings {
+		if errors.Is(err, m.target) {
+			apiErr := apiErrors.NewAPIError(apiErrors.Wrap(m.kind, err))
+			apiErr.Codigo = m.code
+			return apiErr
+		}
+	}
+
+	return apiErrors.NewAPIError(err)
+}
@@ -0,0 +1,45 @@
+package graphql
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/apierror"
+	apiErrors "github.com/fesbarbosa/melivendas-api/pkg/errors"
+	"github.com/fesbarbosa/melivendas-api/pkg/i18n"
+)
+
+type resolverError struct {
+	err error
+	api *apiErrors.APIError
+}
+
+func (e *resolverError) Error() string {
+	return e.api.Mensagem
+}
+
+func (e *resolverError) Unwrap() error {
+	return e.err
+}
+
+func (e *resolverError) Extensions() map[string]interface{} {
+	extensions := map[string]interface{}{
+		"codigo": e.api.Codigo,
+		"status": e.api.Status,
+	}
+	if len(e.api.Campos) > 0 {
+		extensions["campos"] = e.api.Campos
+	}
+	return extensions
+}
+
+func toResolverError(ctx context.Context, err error) error {
+	apiErr := apierror.From(err)
+	if apiErr.Status >= http.StatusInternalServerError {
+		slog.ErrorContext(ctx, "erro interno ao resolver operação GraphQL", "error", err)
+	}
+	apiErr.Localize(i18n.LanguageFromContext(ctx))
+
+	return &resolverError{err: err, api: apiErr}
+}
@@ -0,0 +1,55 @@
+package graphql
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+
+	gql "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+var int64Scalar = gql.NewScalar(gql.ScalarConfig{
+	Name:        "Int64",
+	Description: "Inteiro com sinal de 64 bits, usado para preços em centavos e estoque.",
+	Serialize:   coerceInt64,
+	ParseValue:  coerceInt64,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch valueAST := valueAST.(type) {
+		case *ast.IntValue:
+			return parseInt64(valueAST.Value)
+		case *ast.StringValue:
+			return parseInt64(valueAST.Value)
+		}
+		return nil
+	},
+})
+
+func coerceInt64(value interface{}) interface{} {
+	switch value := value.(type) {
+	case int64:
+		return value
+	case int:
+		return int64(value)
+	case int32:
+		return int64(value)
+	case float64:
+		if value != math.Trunc(value) || value < math.MinInt64 || value >= math.MaxInt64 {
+			return nil
+		}
+		return int64(value)
+	case json.Number:
+		return parseInt64(value.String())
+	case string:
+		return parseInt64(value)
+	}
+	return nil
+}
+
+func parseInt64(raw string) interface{} {
+	value, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return value
+}
@@ -0,0 +1,305 @@
+package graphql
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/input"
+	apiErrors "github.com/fesbarbosa/melivendas-api/pkg/errors"
+	gql "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+var itemStatusEnum = gql.NewEnum(gql.EnumConfig{
+	Name: "ItemStatus",
+	Values: gql.EnumValueConfigMap{
+		string(domain.ItemStatusActive):   &gql.EnumValueConfig{Value: string(domain.ItemStatusActive)},
+		string(domain.ItemStatusInactive): &gql.EnumValueConfig{Value: string(domain.ItemStatusInactive)},
+	},
+})
+
+var itemType = gql.NewObject(gql.ObjectConfig{
+	Name: "Item",
+	Fields: gql.Fields{
+		"id":          &gql.Field{Type: gql.NewNonNull(gql.ID)},
+		"sellerId":    &gql.Field{Type: gql.NewNonNull(gql.String)},
+		"code":        &gql.Field{Type: gql.NewNonNull(gql.String)},
+		"title":       &gql.Field{Type: gql.NewNonNull(gql.String)},
+		"description": &gql.Field{Type: gql.NewNonNull(gql.String)},
+		"category":    &gql.Field{Type: gql.NewNonNull(gql.String)},
+		"price":       &gql.Field{Type: gql.NewNonNull(int64Scalar)},
+		"stock":       &gql.Field{Type: gql.NewNonNull(int64Scalar)},
+		"status":      &gql.Field{Type: gql.NewNonNull(itemStatusEnum)},
+		"createdAt":   &gql.Field{Type: gql.NewNonNull(gql.DateTime)},
+		"updatedAt":   &gql.Field{Type: gql.NewNonNull(gql.DateTime)},
+	},
+})
+
+var itemPageType = gql.NewObject(gql.ObjectConfig{
+	Name: "ItemPage",
+	Fields: gql.Fields{
+		"totalPages": &gql.Field{Type: gql.NewNonNull(gql.Int)},
+		"page":       &gql.Field{Type: gql.NewNonNull(gql.Int)},
+		"items":      &gql.Field{Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(itemType)))},
+	},
+})
+
+var itemInputType = gql.NewInputObject(gql.InputObjectConfig{
+	Name: "ItemInput",
+	Fields: gql.InputObjectConfigFieldMap{
+		"code":        &gql.InputObjectFieldConfig{Type: gql.NewNonNull(gql.String)},
+		"title":       &gql.InputObjectFieldConfig{Type: gql.NewNonNull(gql.String)},
+		"description": &gql.InputObjectFieldConfig{Type: gql.NewNonNull(gql.String)},
+		"category":    &gql.InputObjectFieldConfig{Type: gql.String},
+		"price":       &gql.InputObjectFieldConfig{Type: gql.NewNonNull(int64Scalar)},
+		"stock":       &gql.InputObjectFieldConfig{Type: gql.NewNonNull(int64Scalar)},
+	},
+})
+
+type itemInput struct {
+	code        string
+	title       string
+	description string
+	category    *string
+	price       int64
+	stock       int64
+}
+
+type Executor struct {
+	schema gql.Schema
+}
+
+func NewExecutor(itemService input.ItemService) (*Executor, error) {
+	r := &resolver{itemService: itemService}
+
+	schema, err := gql.NewSchema(gql.SchemaConfig{
+		Query: gql.NewObject(gql.ObjectConfig{
+			Name: "Query",
+			Fields: gql.Fields{
+				"item": &gql.Field{
+					Type:    itemType,
+					Args:    gql.FieldConfigArgument{"id": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.ID)}},
+					Resolve: r.item,
+				},
+				"itemByCode": &gql.Field{
+					Type:    itemType,
+					Args:    gql.FieldConfigArgument{"code": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.String)}},
+					Resolve: r.itemByCode,
+				},
+				"items": &gql.Field{
+					Type: gql.NewNonNull(itemPageType),
+					Args: gql.FieldConfigArgument{
+						"status": &gql.ArgumentConfig{Type: itemStatusEnum},
+						"limit":  &gql.ArgumentConfig{Type: gql.Int, DefaultValue: 10},
+						"page":   &gql.ArgumentConfig{Type: gql.Int, DefaultValue: 1},
+					},
+					Resolve: r.items,
+				},
+			},
+		}),
+		Mutation: gql.NewObject(gql.ObjectConfig{
+			Name: "Mutation",
+			Fields: gql.Fields{
+				"createItem": &gql.Field{
+					Type:    itemType,
+					Args:    gql.FieldConfigArgument{"input": &gql.ArgumentConfig{Type: gql.NewNonNull(itemInputType)}},
+					Resolve: r.createItem,
+				},
+				"updateItem": &gql.Field{
+					Type: itemType,
+					Args: gql.FieldConfigArgument{
+						"id":    &gql.ArgumentConfig{Type: gql.NewNonNull(gql.ID)},
+						"input": &gql.ArgumentConfig{Type: gql.NewNonNull(itemInputType)},
+					},
+					Resolve: r.updateItem,
+				},
+				"deleteItem": &gql.Field{
+					Type:    gql.NewNonNull(gql.Boolean),
+					Args:    gql.FieldConfigArgument{"id": &gql.ArgumentConfig{Type: gql.NewNonNull(gql.ID)}},
+					Resolve: r.deleteItem,
+				},
+			},
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Executor{schema: schema}, nil
+}
+
+func (e *Executor) Execute(ctx context.Context, query, operationName string, variables map[string]interface{}) *gql.Result {
+	return gql.Do(gql.Params{
+		Schema:         e.schema,
+		RequestString:  query,
+		OperationName:  operationName,
+		VariableValues: variables,
+		Context:        ctx,
+	})
+}
+
+func (e *Executor) IsMutation(query, operationName string) bool {
+	document, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return false
+	}
+
+	for _, definition := range document.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		if operationName != "" && (operation.Name == nil || operation.Name.Value != operationName) {
+			continue
+		}
+		if operation.Operation == ast.OperationTypeMutation {
+			return true
+		}
+	}
+
+	return false
+}
+
+type resolver struct {
+	itemService input.ItemService
+}
+
+func (r *resolver) item(p gql.ResolveParams) (interface{}, error) {
+	id, err := parseID(p.Context, p.Args["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := r.itemService.GetItem(p.Context, id)
+	if err != nil {
+		return nil, toResolverError(p.Context, err)
+	}
+
+	return itemToMap(item), nil
+}
+
+func (r *resolver) itemByCode(p gql.ResolveParams) (interface{}, error) {
+	code, _ := p.Args["code"].(string)
+
+	item, err := r.itemService.GetItemByCode(p.Context, code)
+	if err != nil {
+		return nil, toResolverError(p.Context, err)
+	}
+
+	return itemToMap(item), nil
+}
+
+func (r *resolver) items(p gql.ResolveParams) (interface{}, error) {
+	status, _ := p.Args["status"].(string)
+	limit, _ := p.Args["limit"].(int)
+	page, _ := p.Args["page"].(int)
+	if page <= 0 {
+		page = 1
+	}
+
+	result, err := r.itemService.ListItems(p.Context, status, limit, page)
+	if err != nil {
+		return nil, toResolverError(p.Context, err)
+	}
+
+	items := make([]map[string]interface{}, 0, len(result.Dados))
+	for i := range result.Dados {
+		items = append(items, itemToMap(&result.Dados[i]))
+	}
+
+	return map[string]interface{}{
+		"totalPages": result.TotalPaginas,
+		"page":       page,
+		"items":      items,
+	}, nil
+}
+
+func (r *resolver) createItem(p gql.ResolveParams) (interface{}, error) {
+	in := parseItemInput(p.Args["input"])
+
+	var category string
+	if in.category != nil {
+		category = *in.category
+	}
+
+	item, err := r.itemService.CreateItem(p.Context, in.code, in.title, in.description, category, in.price, in.stock)
+	if err != nil {
+		return nil, toResolverError(p.Context, err)
+	}
+
+	return itemToMap(item), nil
+}
+
+func (r *resolver) updateItem(p gql.ResolveParams) (interface{}, error) {
+	id, err := parseID(p.Context, p.Args["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	in := parseItemInput(p.Args["input"])
+
+	item, err := r.itemService.UpdateItem(p.Context, id, in.code, in.title, in.description, in.category, in.price, in.stock)
+	if err != nil {
+		return nil, toResolverError(p.Context, err)
+	}
+
+	return itemToMap(item), nil
+}
+
+func (r *resolver) deleteItem(p gql.ResolveParams) (interface{}, error) {
+	id, err := parseID(p.Context, p.Args["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.itemService.DeleteItem(p.Context, id); err != nil {
+		return nil, toResolverError(p.Context, err)
+	}
+
+	return true, nil
+}
+
+func parseID(ctx context.Context, value interface{}) (int64, error) {
+	raw, _ := value.(string)
+
+	id, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return 0, toResolverError(ctx, apiErrors.Coded(apiErrors.ErrBadRequest, "ID_INVALIDO"))
+	}
+
+	return id, nil
+}
+
+func parseItemInput(value interface{}) itemInput {
+	fields, _ := value.(map[string]interface{})
+
+	in := itemInput{}
+	in.code, _ = fields["code"].(string)
+	in.title, _ = fields["title"].(string)
+	in.description, _ = fields["description"].(string)
+	if category, ok := fields["category"].(string); ok {
+		in.category = &category
+	}
+	in.price, _ = fields["price"].(int64)
+	in.stock, _ = fields["stock"].(int64)
+
+	return in
+}
+
+func itemToMap(item *domain.Item) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          strconv.FormatInt(item.ID, 10),
+		"sellerId":    item.SellerID,
+		"code":        item.Code,
+		"title":       item.Title,
+		"description": item.Description,
+		"category":    item.Category,
+		"price":       item.Price,
+		"stock":       item.Stock,
+		"status":      string(item.Status),
+		"createdAt":   item.CreatedAt,
+		"updatedAt":   item.UpdatedAt,
+	}
+}
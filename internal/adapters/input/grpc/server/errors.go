@@ -0,0 +1,38 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/apierror"
+	"github.com/fesbarbosa/melivendas-api/pkg/i18n"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func toStatusError(ctx context.Context, err error) error {
+	apiErr := apierror.From(err)
+	apiErr.Localize(i18n.LanguageFromContext(ctx))
+
+	var code codes.Code
+	switch apiErr.Status {
+	case http.StatusNotFound:
+		code = codes.NotFound
+	case http.StatusConflict:
+		code = codes.AlreadyExists
+	case http.StatusBadRequest:
+		code = codes.InvalidArgument
+	case http.StatusForbidden:
+		code = codes.PermissionDenied
+	case http.StatusUnauthorized:
+		code = codes.Unauthenticated
+	case http.StatusTooManyRequests:
+		code = codes.ResourceExhausted
+	default:
+		slog.ErrorContext(ctx, "erro interno ao processar chamada gRPC", "error", err)
+		code = codes.Internal
+	}
+
+	return status.Error(code, apiErr.Mensagem)
+}
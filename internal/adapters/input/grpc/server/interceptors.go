@@ -0,0 +1,122 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/input"
+	"github.com/fesbarbosa/melivendas-api/pkg/i18n"
+	"github.com/fesbarbosa/melivendas-api/pkg/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	requestIDMetadata     = "x-request-id"
+	apiKeyMetadata        = "x-api-key"
+	authorizationMetadata = "authorization"
+
+	acceptLanguageMetadata = "accept-language"
+)
+
+type TokenAuthenticator interface {
+	Authenticate(authorization string) (*domain.Principal, error)
+}
+
+func metadataValue(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func requestIDInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		requestID := metadataValue(ctx, requestIDMetadata)
+		if requestID == "" || len(requestID) > 128 {
+			buf := make([]byte, 16)
+			if _, err := rand.Read(buf); err == nil {
+				requestID = hex.EncodeToString(buf)
+			}
+		}
+
+		_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDMetadata, requestID))
+
+		return handler(logger.ContextWithRequestID(ctx, requestID), req)
+	}
+}
+
+func languageInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		lang := i18n.Negotiate(metadataValue(ctx, acceptLanguageMetadata))
+		return handler(i18n.ContextWithLanguage(ctx, lang), req)
+	}
+}
+
+func recoveryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				slog.ErrorContext(ctx, "panic em chamada gRPC", "method", info.FullMethod, "panic", r, "stack", string(debug.Stack()))
+				err = status.Error(codes.Internal, "erro interno do servidor")
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+func loggingInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		code := status.Code(err)
+		level := slog.LevelInfo
+		switch code {
+		case codes.OK, codes.NotFound, codes.AlreadyExists, codes.InvalidArgument, codes.PermissionDenied, codes.Unauthenticated:
+		default:
+			level = slog.LevelError
+		}
+
+		slog.Log(ctx, level, "chamada gRPC",
+			"method", info.FullMethod,
+			"code", code.String(),
+			"duration_ms", time.Since(start).Milliseconds(),
+		)
+
+		return resp, err
+	}
+}
+
+func authInterceptor(apiKeyService input.APIKeyService, authenticator TokenAuthenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		var principal *domain.Principal
+		var err error
+
+		if rawKey := metadataValue(ctx, apiKeyMetadata); rawKey != "" {
+			principal, err = apiKeyService.AuthenticateAPIKey(ctx, rawKey)
+		} else {
+			principal, err = authenticator.Authenticate(metadataValue(ctx, authorizationMetadata))
+		}
+		if err != nil {
+			return nil, toStatusError(ctx, err)
+		}
+
+		return handler(domain.ContextWithPrincipal(ctx, principal), req)
+	}
+}
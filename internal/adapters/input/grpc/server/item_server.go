@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/grpc/pb"
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/input"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ItemServer struct {
+	pb.UnimplementedItemServiceServer
+
+	itemService input.ItemService
+}
+
+func NewItemServer(itemService input.ItemService) *ItemServer {
+	return &ItemServer{
+		itemService: itemService,
+	}
+}
+
+func (s *ItemServer) CreateItem(ctx context.Context, req *pb.CreateItemRequest) (*pb.Item, error) {
+	item, err := s.itemService.CreateItem(ctx, req.GetCode(), req.GetTitle(), req.GetDescription(), req.GetCategory(), req.GetPrice(), req.GetStock())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return toProtoItem(item), nil
+}
+
+func (s *ItemServer) GetItem(ctx context.Context, req *pb.GetItemRequest) (*pb.Item, error) {
+	item, err := s.itemService.GetItem(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return toProtoItem(item), nil
+}
+
+func (s *ItemServer) UpdateItem(ctx context.Context, req *pb.UpdateItemRequest) (*pb.Item, error) {
+	item, err := s.itemService.UpdateItem(ctx, req.GetId(), req.GetCode(), req.GetTitle(), req.GetDescription(), req.Category, req.GetPrice(), req.GetStock())
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return toProtoItem(item), nil
+}
+
+func (s *ItemServer) DeleteItem(ctx context.Context, req *pb.DeleteItemRequest) (*pb.DeleteItemResponse, error) {
+	if err := s.itemService.DeleteItem(ctx, req.GetId()); err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	return &pb.DeleteItemResponse{}, nil
+}
+
+func (s *ItemServer) ListItems(ctx context.Context, req *pb.ListItemsRequest) (*pb.ListItemsResponse, error) {
+	result, err := s.itemService.ListItems(ctx, req.GetStatus(), int(req.GetLimit()), int(req.GetPage()))
+	if err != nil {
+		return nil, toStatusError(ctx, err)
+	}
+
+	items := make([]*pb.Item, 0, len(result.Dados))
+	for i := range result.Dados {
+		items = append(items, toProtoItem(&result.Dados[i]))
+	}
+
+	return &pb.ListItemsResponse{
+		TotalPages: int32(result.TotalPaginas),
+		Items:      items,
+	}, nil
+}
+
+func toProtoItem(item *domain.Item) *pb.Item {
+	return &pb.Item{
+		Id:          item.ID,
+		SellerId:    item.SellerID,
+		Code:        item.Code,
+		Title:       item.Title,
+		Description: item.Description,
+		Category:    item.Category,
+		Price:       item.Price,
+		Stock:       item.Stock,
+		Status:      string(item.Status),
+		CreatedAt:   timestamppb.New(item.CreatedAt),
+		UpdatedAt:   timestamppb.New(item.UpdatedAt),
+	}
+}
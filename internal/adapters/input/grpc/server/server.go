@@ -0,0 +1,28 @@
+package server
+
+import (
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/grpc/pb"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/input"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+func New(itemService input.ItemService, apiKeyService input.APIKeyService, authenticator TokenAuthenticator, enableReflection bool) *grpc.Server {
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			requestIDInterceptor(),
+			languageInterceptor(),
+			recoveryInterceptor(),
+			loggingInterceptor(),
+			authInterceptor(apiKeyService, authenticator),
+		),
+	)
+
+	pb.RegisterItemServiceServer(srv, NewItemServer(itemService))
+
+	if enableReflection {
+		reflection.Register(srv)
+	}
+
+	return srv
+}
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/response"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/input"
+	"github.com/gin-gonic/gin"
+)
+
+type APIKeyHandler struct {
+	apiKeyService input.APIKeyService
+}
+
+func NewAPIKeyHandler(apiKeyService input.APIKeyService) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyService: apiKeyService,
+	}
+}
+
+type APIKeyRequest struct {
+	Name      string     `json:"name" binding:"required,min=1"`
+	Scopes    []string   `json:"scopes" binding:"required,min=1"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type RotateAPIKeyRequest struct {
+	OverlapSeconds int64 `json:"overlap_seconds" binding:"gte=0"`
+}
+
+func (h *APIKeyHandler) Create(c *gin.Context) {
+	var req APIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	created, err := h.apiKeyService.CreateAPIKey(c.Request.Context(), req.Name, req.Scopes, req.ExpiresAt)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, ItemResponse{
+		Sucesso:  true,
+		Mensagem: response.Message(c, "CHAVE_API_CRIADA"),
+		Dados:    created,
+	})
+}
+
+func (h *APIKeyHandler) List(c *gin.Context) {
+	keys, err := h.apiKeyService.ListAPIKeys(c.Request.Context())
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ItemResponse{
+		Sucesso: true,
+		Dados:   keys,
+	})
+}
+
+func (h *APIKeyHandler) Revoke(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	err := h.apiKeyService.RevokeAPIKey(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ItemResponse{
+		Sucesso:  true,
+		Mensagem: response.Message(c, "CHAVE_API_REVOGADA"),
+	})
+}
+
+func (h *APIKeyHandler) Rotate(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	var req RotateAPIKeyRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.BindingError(c, err)
+			return
+		}
+	}
+
+	created, err := h.apiKeyService.RotateAPIKey(
+		c.Request.Context(),
+		id,
+		time.Duration(req.OverlapSeconds)*time.Second,
+	)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, ItemResponse{
+		Sucesso:  true,
+		Mensagem: response.Message(c, "CHAVE_API_ROTACIONADA"),
+		Dados:    created,
+	})
+}
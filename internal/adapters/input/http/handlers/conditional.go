@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/gin-gonic/gin"
+)
+
+func itemETag(item *domain.PricedItem) string {
+	hash := sha256.New()
+	writePricedItem(hash, item)
+	return fmt.Sprintf(`W/"%d-%s"`, item.ID, hex.EncodeToString(hash.Sum(nil)[:8]))
+}
+
+func pagedItemsETag(result *PagedItems) string {
+	hash := sha256.New()
+
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, uint64(result.TotalPaginas))
+	hash.Write(buf)
+
+	for i := range result.Dados {
+		writePricedItem(hash, &result.Dados[i])
+	}
+
+	return `W/"` + hex.EncodeToString(hash.Sum(nil)[:16]) + `"`
+}
+
+func writePricedItem(w io.Writer, item *domain.PricedItem) {
+	buf := make([]byte, 8)
+	for _, value := range []int64{item.ID, item.UpdatedAt.UnixNano(), item.EffectivePrice} {
+		binary.BigEndian.PutUint64(buf, uint64(value))
+		w.Write(buf)
+	}
+	for _, promotion := range item.AppliedPromotions {
+		binary.BigEndian.PutUint64(buf, uint64(promotion.ID))
+		w.Write(buf)
+	}
+}
+
+func writeValidators(c *gin.Context, cacheControl, etag string, lastModified time.Time) bool {
+	c.Header("ETag", etag)
+	if !lastModified.IsZero() {
+		c.Header("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+	if cacheControl != "" {
+		c.Header("Cache-Control", cacheControl)
+	}
+
+	if ifNoneMatch := c.GetHeader("If-None-Match"); ifNoneMatch != "" {
+		if !etagMatches(ifNoneMatch, etag) {
+			return false
+		}
+	} else {
+		ifModifiedSince := c.GetHeader("If-Modified-Since")
+		if ifModifiedSince == "" || lastModified.IsZero() {
+			return false
+		}
+		since, err := http.ParseTime(ifModifiedSince)
+		if err != nil || lastModified.Truncate(time.Second).After(since) {
+			return false
+		}
+	}
+
+	c.Status(http.StatusNotModified)
+	return true
+}
+
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/graphql"
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/response"
+	apiErrors "github.com/fesbarbosa/melivendas-api/pkg/errors"
+	"github.com/fesbarbosa/melivendas-api/pkg/i18n"
+	"github.com/gin-gonic/gin"
+)
+
+type GraphQLHandler struct {
+	executor *graphql.Executor
+}
+
+func NewGraphQLHandler(executor *graphql.Executor) *GraphQLHandler {
+	return &GraphQLHandler{
+		executor: executor,
+	}
+}
+
+type GraphQLRequest struct {
+	Query         string                 `json:"query" binding:"required,min=1"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+func (h *GraphQLHandler) Query(c *gin.Context) {
+	var req GraphQLRequest
+
+	if c.Request.Method == http.MethodGet {
+		req.Query = c.Query("query")
+		req.OperationName = c.Query("operationName")
+		if variables := c.Query("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				response.ValidationError(c, []apiErrors.FieldError{apiErrors.NewFieldError("variables", "JSON_INVALIDO")})
+				return
+			}
+		}
+		if h.executor.IsMutation(req.Query, req.OperationName) {
+			c.Header("Allow", http.MethodPost)
+			response.APIError(c, &apiErrors.APIError{
+				Status:   http.StatusMethodNotAllowed,
+				Codigo:   "MUTACAO_REQUER_POST",
+				Mensagem: i18n.Translate(i18n.Default, "MUTACAO_REQUER_POST"),
+			})
+			return
+		}
+	} else if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	result := h.executor.Execute(c.Request.Context(), req.Query, req.OperationName, req.Variables)
+
+	c.JSON(http.StatusOK, result)
+}
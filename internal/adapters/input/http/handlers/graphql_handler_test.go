@@ -0,0 +1,117 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/graphql"
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/handlers"
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/input"
+	"github.com/gin-gonic/gin"
+)
+
+type createOnlyItemService struct {
+	input.ItemService
+	created int
+}
+
+func (s *createOnlyItemService) CreateItem(ctx context.Context, code, title, description, category string, price, stock int64) (*domain.Item, error) {
+	s.created++
+	return &domain.Item{ID: 1, Code: code, Title: title, Description: description, Category: category, Price: price, Stock: stock, Status: domain.ItemStatusActive}, nil
+}
+
+func newGraphQLRouter(t *testing.T, service input.ItemService) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	executor, err := graphql.NewExecutor(service)
+	if err != nil {
+		t.Fatalf("falha ao montar schema: %v", err)
+	}
+
+	handler := handlers.NewGraphQLHandler(executor)
+	router := gin.New()
+	router.GET("/graphql", handler.Query)
+	router.POST("/graphql", handler.Query)
+	return router
+}
+
+const createItemMutation = `mutation Create {
+	createItem(input: {code: "ABC", title: "Item", description: "Desc", price: 5000000000, stock: 3000000000}) { price stock }
+}`
+
+func TestGraphQLGetRejectsMutations(t *testing.T) {
+	service := &createOnlyItemService{}
+	router := newGraphQLRouter(t, service)
+
+	tests := []struct {
+		name          string
+		query         string
+		operationName string
+	}{
+		{name: "mutação anônima", query: `mutation { deleteItem(id: "1") }`},
+		{name: "mutação nomeada", query: createItemMutation, operationName: "Create"},
+		{name: "mutação escolhida entre operações", query: "query Get { item(id: \"1\") { id } }\n" + createItemMutation, operationName: "Create"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := url.Values{"query": {tt.query}}
+			if tt.operationName != "" {
+				params.Set("operationName", tt.operationName)
+			}
+			req := httptest.NewRequest(http.MethodGet, "/graphql?"+params.Encode(), nil)
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusMethodNotAllowed {
+				t.Fatalf("status = %d, esperado %d: %s", rec.Code, http.StatusMethodNotAllowed, rec.Body.String())
+			}
+			if allow := rec.Header().Get("Allow"); allow != http.MethodPost {
+				t.Errorf("Allow = %q, esperado %q", allow, http.MethodPost)
+			}
+		})
+	}
+
+	if service.created != 0 {
+		t.Fatalf("mutação executada via GET %d vezes", service.created)
+	}
+}
+
+func TestGraphQLPriceAndStockAcceptInt64(t *testing.T) {
+	service := &createOnlyItemService{}
+	router := newGraphQLRouter(t, service)
+
+	body, _ := json.Marshal(map[string]string{"query": createItemMutation})
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body)))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	var result struct {
+		Data struct {
+			CreateItem struct {
+				Price int64 `json:"price"`
+				Stock int64 `json:"stock"`
+			} `json:"createItem"`
+		} `json:"data"`
+		Errors []json.RawMessage `json:"errors"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("resposta inválida: %v", err)
+	}
+	if len(result.Errors) > 0 {
+		t.Fatalf("erros inesperados: %s", rec.Body.String())
+	}
+	if result.Data.CreateItem.Price != 5000000000 || result.Data.CreateItem.Stock != 3000000000 {
+		t.Fatalf("valores truncados: %+v", result.Data.CreateItem)
+	}
+}
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const defaultReadinessTimeout = 2 * time.Second
+
+type ReadinessCheck struct {
+	Name    string
+	Timeout time.Duration
+	Check   func(ctx context.Context) error
+}
+
+type HealthHandler struct {
+	checks       []ReadinessCheck
+	shuttingDown atomic.Bool
+}
+
+func NewHealthHandler(checks ...ReadinessCheck) *HealthHandler {
+	return &HealthHandler{
+		checks: checks,
+	}
+}
+
+type DependencyStatus struct {
+	Status string `json:"status"`
+}
+
+type ReadinessResponse struct {
+	Status       string                      `json:"status"`
+	Dependencias map[string]DependencyStatus `json:"dependencias"`
+}
+
+func (h *HealthHandler) SetShuttingDown() {
+	h.shuttingDown.Store(true)
+}
+
+func (h *HealthHandler) Liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+func (h *HealthHandler) Readiness(c *gin.Context) {
+	if h.shuttingDown.Load() {
+		c.JSON(http.StatusServiceUnavailable, ReadinessResponse{
+			Status:       "shutting_down",
+			Dependencias: map[string]DependencyStatus{},
+		})
+		return
+	}
+
+	results := make(map[string]DependencyStatus, len(h.checks))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, check := range h.checks {
+		wg.Add(1)
+		go func(check ReadinessCheck) {
+			defer wg.Done()
+			status := runReadinessCheck(c.Request.Context(), check)
+
+			mu.Lock()
+			results[check.Name] = status
+			mu.Unlock()
+		}(check)
+	}
+	wg.Wait()
+
+	statusCode := http.StatusOK
+	overall := "ready"
+	for _, result := range results {
+		if result.Status != "up" {
+			statusCode = http.StatusServiceUnavailable
+			overall = "not_ready"
+			break
+		}
+	}
+
+	c.JSON(statusCode, ReadinessResponse{
+		Status:       overall,
+		Dependencias: results,
+	})
+}
+
+func runReadinessCheck(ctx context.Context, check ReadinessCheck) DependencyStatus {
+	timeout := check.Timeout
+	if timeout <= 0 {
+		timeout = defaultReadinessTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	if err := check.Check(ctx); err != nil {
+		slog.WarnContext(ctx, "verificação de prontidão falhou",
+			"check", check.Name,
+			"latency_ms", time.Since(start).Milliseconds(),
+			"error", err,
+		)
+		return DependencyStatus{Status: "down"}
+	}
+
+	return DependencyStatus{Status: "up"}
+}
@@ -0,0 +1,45 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+func TestReadinessHidesCheckErrors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	const detail = "dial tcp 10.0.3.7:3306: senha incorreta para root"
+
+	handler := handlers.NewHealthHandler(
+		handlers.ReadinessCheck{Name: "mysql", Check: func(ctx context.Context) error { return errors.New(detail) }},
+		handlers.ReadinessCheck{Name: "cache", Check: func(ctx context.Context) error { return nil }},
+	)
+
+	router := gin.New()
+	router.GET("/readyz", handler.Readiness)
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status = %d, esperado %d", rec.Code, http.StatusServiceUnavailable)
+	}
+	if strings.Contains(rec.Body.String(), "10.0.3.7") || strings.Contains(rec.Body.String(), "senha") {
+		t.Fatalf("resposta expõe o erro da dependência: %s", rec.Body.String())
+	}
+
+	var body handlers.ReadinessResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("resposta inválida: %v", err)
+	}
+	if body.Status != "not_ready" || body.Dependencias["mysql"].Status != "down" || body.Dependencias["cache"].Status != "up" {
+		t.Fatalf("resposta = %+v", body)
+	}
+}
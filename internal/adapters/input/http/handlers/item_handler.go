@@ -1,33 +1,45 @@
 package handlers
 
 import (
-	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/response"
 	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
 	"github.com/fesbarbosa/melivendas-api/internal/core/ports/input"
-	"github.com/fesbarbosa/melivendas-api/internal/core/services"
-	apiErrors "github.com/fesbarbosa/melivendas-api/pkg/errors"
 	"github.com/gin-gonic/gin"
 )
 
 type ItemHandler struct {
-	itemService input.ItemService
+	itemService    input.ItemService
+	pricingService input.PricingService
+	cacheControl   string
 }
 
-func NewItemHandler(itemService input.ItemService) *ItemHandler {
+func NewItemHandler(itemService input.ItemService, pricingService input.PricingService, cacheControl string) *ItemHandler {
 	return &ItemHandler{
-		itemService: itemService,
+		itemService:    itemService,
+		pricingService: pricingService,
+		cacheControl:   cacheControl,
 	}
 }
 
 type ItemRequest struct {
-	Code        string `json:"code" binding:"required"`
-	Title       string `json:"title" binding:"required"`
-	Description string `json:"description" binding:"required"`
-	Price       int64  `json:"price" binding:"required,gt=0"`
-	Stock       int64  `json:"stock" binding:"required,gte=0"`
+	Code        string  `json:"code" binding:"required"`
+	Title       string  `json:"title" binding:"required"`
+	Description string  `json:"description" binding:"required"`
+	Category    *string `json:"category"`
+	Price       int64   `json:"price" binding:"required,gt=0"`
+	Stock       *int64  `json:"stock" binding:"required,gte=0"`
+}
+
+type ItemUpsertRequest struct {
+	Title       string  `json:"title" binding:"required"`
+	Description string  `json:"description" binding:"required"`
+	Category    *string `json:"category"`
+	Price       int64   `json:"price" binding:"required,gt=0"`
+	Stock       int64   `json:"stock" binding:"gte=0"`
 }
 
 type ItemResponse struct {
@@ -37,16 +49,14 @@ type ItemResponse struct {
 }
 
 type PagedItems struct {
-	TotalPaginas int           `json:"totalPaginas"`
-	Dados        []domain.Item `json:"dados"`
+	TotalPaginas int                 `json:"totalPaginas"`
+	Dados        []domain.PricedItem `json:"dados"`
 }
 
 func (h *ItemHandler) Create(c *gin.Context) {
 	var req ItemRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, apiErrors.NewAPIError(
-			errors.Join(apiErrors.ErrBadRequest, err),
-		))
+		response.BindingError(c, err)
 		return
 	}
 
@@ -55,70 +65,66 @@ func (h *ItemHandler) Create(c *gin.Context) {
 		req.Code,
 		req.Title,
 		req.Description,
+		categoryValue(req.Category),
 		req.Price,
-		req.Stock,
+		*req.Stock,
 	)
 
 	if err != nil {
-		var statusCode int
-		switch {
-		case errors.Is(err, services.ErrDuplicateCode):
-			statusCode = http.StatusConflict
-		case errors.Is(err, services.ErrInvalidData):
-			statusCode = http.StatusBadRequest
-		default:
-			statusCode = http.StatusInternalServerError
-		}
-		c.JSON(statusCode, gin.H{"sucesso": false, "erro": err.Error()})
+		response.Error(c, err)
+		return
+	}
+
+	priced, err := h.pricingService.PriceItem(c.Request.Context(), item)
+	if err != nil {
+		response.Error(c, err)
 		return
 	}
 
 	c.JSON(http.StatusCreated, ItemResponse{
 		Sucesso:  true,
-		Mensagem: "Item criado com sucesso",
-		Dados:    item,
+		Mensagem: response.Message(c, "ITEM_CRIADO"),
+		Dados:    priced,
 	})
 }
 
 func (h *ItemHandler) GetByID(c *gin.Context) {
-	idStr := c.Param("id")
-	id, err := strconv.ParseInt(idStr, 10, 64)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"sucesso": false, "erro": "ID de item inválido"})
+	id, ok := parseIDParam(c, "id")
+	if !ok {
 		return
 	}
 
 	item, err := h.itemService.GetItem(c.Request.Context(), id)
 	if err != nil {
-		var statusCode int
-		if errors.Is(err, services.ErrItemNotFound) {
-			statusCode = http.StatusNotFound
-		} else {
-			statusCode = http.StatusInternalServerError
-		}
-		c.JSON(statusCode, gin.H{"sucesso": false, "erro": err.Error()})
+		response.Error(c, err)
+		return
+	}
+
+	priced, err := h.pricingService.PriceItem(c.Request.Context(), item)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	if writeValidators(c, h.cacheControl, itemETag(priced), priced.PricingChangedAt) {
 		return
 	}
 
 	c.JSON(http.StatusOK, ItemResponse{
 		Sucesso: true,
-		Dados:   item,
+		Dados:   priced,
 	})
 }
 
 func (h *ItemHandler) Update(c *gin.Context) {
-	idStr := c.Param("id")
-	id, err := strconv.ParseInt(idStr, 10, 64)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"sucesso": false, "erro": "ID de item inválido"})
+	id, ok := parseIDParam(c, "id")
+	if !ok {
 		return
 	}
 
 	var req ItemRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, apiErrors.NewAPIError(
-			errors.Join(apiErrors.ErrBadRequest, err),
-		))
+		response.BindingError(c, err)
 		return
 	}
 
@@ -128,56 +134,130 @@ func (h *ItemHandler) Update(c *gin.Context) {
 		req.Code,
 		req.Title,
 		req.Description,
+		req.Category,
 		req.Price,
-		req.Stock,
+		*req.Stock,
 	)
 
 	if err != nil {
-		var statusCode int
-		switch {
-		case errors.Is(err, services.ErrItemNotFound):
-			statusCode = http.StatusNotFound
-		case errors.Is(err, services.ErrDuplicateCode):
-			statusCode = http.StatusConflict
-		case errors.Is(err, services.ErrInvalidData):
-			statusCode = http.StatusBadRequest
-		default:
-			statusCode = http.StatusInternalServerError
-		}
-		c.JSON(statusCode, gin.H{"sucesso": false, "erro": err.Error()})
+		response.Error(c, err)
+		return
+	}
+
+	priced, err := h.pricingService.PriceItem(c.Request.Context(), item)
+	if err != nil {
+		response.Error(c, err)
 		return
 	}
 
 	c.JSON(http.StatusOK, ItemResponse{
 		Sucesso:  true,
-		Mensagem: "Item atualizado com sucesso",
-		Dados:    item,
+		Mensagem: response.Message(c, "ITEM_ATUALIZADO"),
+		Dados:    priced,
 	})
 }
 
 func (h *ItemHandler) Delete(c *gin.Context) {
-	idStr := c.Param("id")
-	id, err := strconv.ParseInt(idStr, 10, 64)
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	err := h.itemService.DeleteItem(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"sucesso": false, "erro": "ID de item inválido"})
+		response.Error(c, err)
 		return
 	}
 
-	err = h.itemService.DeleteItem(c.Request.Context(), id)
+	c.JSON(http.StatusOK, ItemResponse{
+		Sucesso:  true,
+		Mensagem: response.Message(c, "ITEM_EXCLUIDO"),
+	})
+}
+
+func (h *ItemHandler) GetByCode(c *gin.Context) {
+	code := c.Param("code")
+
+	item, err := h.itemService.GetItemByCode(c.Request.Context(), code)
 	if err != nil {
-		var statusCode int
-		if errors.Is(err, services.ErrItemNotFound) {
-			statusCode = http.StatusNotFound
-		} else {
-			statusCode = http.StatusInternalServerError
-		}
-		c.JSON(statusCode, gin.H{"sucesso": false, "erro": err.Error()})
+		response.Error(c, err)
+		return
+	}
+
+	priced, err := h.pricingService.PriceItem(c.Request.Context(), item)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	if writeValidators(c, h.cacheControl, itemETag(priced), priced.PricingChangedAt) {
+		return
+	}
+
+	c.JSON(http.StatusOK, ItemResponse{
+		Sucesso: true,
+		Dados:   priced,
+	})
+}
+
+func (h *ItemHandler) UpsertByCode(c *gin.Context) {
+	code := c.Param("code")
+
+	var req ItemUpsertRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	item, created, err := h.itemService.UpsertItemByCode(
+		c.Request.Context(),
+		code,
+		req.Title,
+		req.Description,
+		req.Category,
+		req.Price,
+		req.Stock,
+	)
+
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	priced, err := h.pricingService.PriceItem(c.Request.Context(), item)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	if created {
+		c.JSON(http.StatusCreated, ItemResponse{
+			Sucesso:  true,
+			Mensagem: response.Message(c, "ITEM_CRIADO"),
+			Dados:    priced,
+		})
 		return
 	}
 
 	c.JSON(http.StatusOK, ItemResponse{
 		Sucesso:  true,
-		Mensagem: "Item excluído com sucesso",
+		Mensagem: response.Message(c, "ITEM_ATUALIZADO"),
+		Dados:    priced,
+	})
+}
+
+func (h *ItemHandler) DeleteByCode(c *gin.Context) {
+	code := c.Param("code")
+
+	err := h.itemService.DeleteItemByCode(c.Request.Context(), code)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ItemResponse{
+		Sucesso:  true,
+		Mensagem: response.Message(c, "ITEM_EXCLUIDO"),
 	})
 }
 
@@ -206,9 +286,31 @@ func (h *ItemHandler) List(c *gin.Context) {
 
 	result, err := h.itemService.ListItems(c.Request.Context(), status, limit, page)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"sucesso": false, "erro": err.Error()})
+		response.Error(c, err)
+		return
+	}
+
+	priced, err := h.pricingService.PriceItems(c.Request.Context(), result.Dados)
+	if err != nil {
+		response.Error(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, result)
+	paged := &PagedItems{
+		TotalPaginas: result.TotalPaginas,
+		Dados:        priced,
+	}
+
+	if writeValidators(c, h.cacheControl, pagedItemsETag(paged), time.Time{}) {
+		return
+	}
+
+	c.JSON(http.StatusOK, paged)
+}
+
+func categoryValue(category *string) string {
+	if category == nil {
+		return ""
+	}
+	return *category
 }
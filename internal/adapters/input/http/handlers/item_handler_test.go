@@ -0,0 +1,157 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/handlers"
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/input"
+	apiErrors "github.com/fesbarbosa/melivendas-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+func TestCreateItemReportsFieldErrorsWithoutOpenAPIValidation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/v1/items", handlers.NewItemHandler(nil, nil, "").Create)
+
+	body := `{"code":"ABC","title":"","price":0,"stock":1}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/items", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, esperado %d: %s", rec.Code, http.StatusBadRequest, rec.Body.String())
+	}
+
+	var apiErr apiErrors.APIError
+	if err := json.Unmarshal(rec.Body.Bytes(), &apiErr); err != nil {
+		t.Fatalf("resposta inválida: %v", err)
+	}
+
+	fields := make(map[string]string)
+	for _, field := range apiErr.Campos {
+		fields[field.Campo] = field.Codigo
+	}
+
+	for _, name := range []string{"title", "description", "price"} {
+		if _, ok := fields[name]; !ok {
+			t.Errorf("esperava erro para o campo %q, obteve %v", name, apiErr.Campos)
+		}
+	}
+}
+
+type createItemService struct {
+	input.ItemService
+}
+
+func (createItemService) CreateItem(ctx context.Context, code, title, description, category string, price, stock int64) (*domain.Item, error) {
+	item := domain.NewItem(code, title, description, category, price, stock)
+	item.ID = 1
+	return item, nil
+}
+
+func TestCreateItemAcceptsZeroStock(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/v1/items", handlers.NewItemHandler(createItemService{}, flatPricingService{}, "").Create)
+
+	body := `{"code":"A","title":"B","description":"C","price":10,"stock":0}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/items", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("status = %d, esperado %d: %s", rec.Code, http.StatusCreated, rec.Body.String())
+	}
+
+	var resp struct {
+		Dados domain.PricedItem `json:"dados"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("resposta inválida: %v", err)
+	}
+	if resp.Dados.Stock != 0 || resp.Dados.Status != domain.ItemStatusInactive {
+		t.Fatalf("item = %+v, esperado estoque 0 e status %s", resp.Dados.Item, domain.ItemStatusInactive)
+	}
+}
+
+type pagedItemService struct {
+	input.ItemService
+
+	items []domain.Item
+}
+
+func (s *pagedItemService) ListItems(ctx context.Context, status string, limit, page int) (*domain.PagedItems, error) {
+	return &domain.PagedItems{TotalPaginas: 1, Dados: s.items}, nil
+}
+
+type flatPricingService struct {
+	input.PricingService
+}
+
+func (flatPricingService) PriceItem(ctx context.Context, item *domain.Item) (*domain.PricedItem, error) {
+	return &domain.PricedItem{Item: *item, OriginalPrice: item.Price, EffectivePrice: item.Price, PricingChangedAt: item.UpdatedAt}, nil
+}
+
+func (flatPricingService) PriceItems(ctx context.Context, items []domain.Item) ([]domain.PricedItem, error) {
+	priced := make([]domain.PricedItem, len(items))
+	for i, item := range items {
+		priced[i] = domain.PricedItem{Item: item, OriginalPrice: item.Price, EffectivePrice: item.Price, PricingChangedAt: item.UpdatedAt}
+	}
+	return priced, nil
+}
+
+func TestListItemsRevalidatesOnlyByETag(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	updatedAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	service := &pagedItemService{items: []domain.Item{
+		{ID: 1, Code: "A", Price: 100, UpdatedAt: updatedAt},
+		{ID: 2, Code: "B", Price: 200, UpdatedAt: updatedAt},
+	}}
+
+	router := gin.New()
+	router.GET("/v1/items", handlers.NewItemHandler(service, flatPricingService{}, "").List)
+
+	list := func(header, value string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/v1/items", nil)
+		if header != "" {
+			req.Header.Set(header, value)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	first := list("", "")
+	if first.Code != http.StatusOK {
+		t.Fatalf("status = %d, esperado %d", first.Code, http.StatusOK)
+	}
+	if got := first.Header().Get("Last-Modified"); got != "" {
+		t.Fatalf("listagem não deveria enviar Last-Modified, obteve %q", got)
+	}
+	etag := first.Header().Get("ETag")
+
+	if rec := list("If-None-Match", etag); rec.Code != http.StatusNotModified {
+		t.Fatalf("com o mesmo ETag: status = %d, esperado %d", rec.Code, http.StatusNotModified)
+	}
+
+	service.items = service.items[:1]
+
+	if rec := list("If-None-Match", etag); rec.Code != http.StatusOK {
+		t.Fatalf("após remover um item: status = %d, esperado %d", rec.Code, http.StatusOK)
+	}
+	if rec := list("If-Modified-Since", updatedAt.Add(time.Hour).Format(http.TimeFormat)); rec.Code != http.StatusOK {
+		t.Fatalf("If-Modified-Since após remover um item: status = %d, esperado %d", rec.Code, http.StatusOK)
+	}
+}
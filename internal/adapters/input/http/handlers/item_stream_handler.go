@@ -0,0 +1,182 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/response"
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/input"
+	apiErrors "github.com/fesbarbosa/melivendas-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+const defaultStreamHeartbeat = 15 * time.Second
+
+type ItemStreamHandler struct {
+	streamService input.ItemStreamService
+	heartbeat     time.Duration
+
+	done     chan struct{}
+	doneOnce sync.Once
+}
+
+func NewItemStreamHandler(streamService input.ItemStreamService, heartbeat time.Duration) *ItemStreamHandler {
+	if heartbeat <= 0 {
+		heartbeat = defaultStreamHeartbeat
+	}
+
+	return &ItemStreamHandler{
+		streamService: streamService,
+		heartbeat:     heartbeat,
+		done:          make(chan struct{}),
+	}
+}
+
+func (h *ItemStreamHandler) Shutdown() {
+	h.doneOnce.Do(func() {
+		close(h.done)
+	})
+}
+
+func parseItemChangeFilter(c *gin.Context) (domain.ItemChangeFilter, error) {
+	var filter domain.ItemChangeFilter
+
+	if ids := c.Query("ids"); ids != "" {
+		for _, raw := range strings.Split(ids, ",") {
+			raw = strings.TrimSpace(raw)
+			if raw == "" {
+				continue
+			}
+			id, err := strconv.ParseInt(raw, 10, 64)
+			if err != nil || id <= 0 {
+				return filter, apiErrors.Coded(apiErrors.ErrBadRequest, "FILTRO_ID_INVALIDO", raw)
+			}
+			filter.ItemIDs = append(filter.ItemIDs, id)
+		}
+	}
+
+	if status := c.Query("status"); status != "" {
+		filter.Status = domain.ItemStatus(strings.ToUpper(status))
+		if filter.Status != domain.ItemStatusActive && filter.Status != domain.ItemStatusInactive {
+			return filter, apiErrors.Coded(apiErrors.ErrBadRequest, "FILTRO_STATUS_INVALIDO", status)
+		}
+	}
+
+	return filter, nil
+}
+
+func lastEventID(c *gin.Context) (int64, error) {
+	raw := c.GetHeader("Last-Event-ID")
+	if raw == "" {
+		raw = c.Query("lastEventId")
+	}
+	if raw == "" {
+		return 0, nil
+	}
+
+	id, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || id < 0 {
+		return 0, apiErrors.Coded(apiErrors.ErrBadRequest, "LAST_EVENT_ID_INVALIDO")
+	}
+	return id, nil
+}
+
+func writeItemChange(c *gin.Context, change domain.ItemChange) error {
+	data, err := json.Marshal(change)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(c.Writer, "id: %d\nevent: %s\ndata: %s\n\n", change.Sequence, change.Type, data)
+	return err
+}
+
+func writeReset(c *gin.Context) error {
+	data, err := json.Marshal(map[string]string{
+		"codigo": "HISTORICO_EVENTOS_INDISPONIVEL",
+		"motivo": response.Message(c, "HISTORICO_EVENTOS_INDISPONIVEL"),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(c.Writer, "event: reset\ndata: %s\n\n", data)
+	return err
+}
+
+func (h *ItemStreamHandler) Stream(c *gin.Context) {
+	filter, err := parseItemChangeFilter(c)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	lastSequence, err := lastEventID(c)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	subscription, err := h.streamService.Subscribe(ctx, filter, lastSequence)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+	defer subscription.Close()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	fmt.Fprintf(c.Writer, "retry: %d\n\n", 3000)
+
+	if subscription.Truncated {
+		if err := writeReset(c); err != nil {
+			return
+		}
+	}
+
+	for _, change := range subscription.Replay {
+		if err := writeItemChange(c, change); err != nil {
+			return
+		}
+	}
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-h.done:
+			return
+		case change, ok := <-subscription.Changes:
+			if !ok {
+				slog.WarnContext(ctx, "stream de itens encerrado pelo servidor")
+				return
+			}
+			if err := writeItemChange(c, change); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(c.Writer, ": heartbeat\n\n"); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+}
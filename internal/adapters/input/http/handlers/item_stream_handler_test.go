@@ -0,0 +1,56 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/handlers"
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/pkg/i18n"
+	"github.com/gin-gonic/gin"
+)
+
+type truncatedStreamService struct{}
+
+func (truncatedStreamService) Subscribe(ctx context.Context, filter domain.ItemChangeFilter, lastSequence int64) (*domain.ItemChangeSubscription, error) {
+	return &domain.ItemChangeSubscription{Truncated: true, Changes: make(chan domain.ItemChange), Close: func() {}}, nil
+}
+
+func TestStreamLocalizesResetEventAndToleratesZeroHeartbeat(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		lang i18n.Language
+		want string
+	}{
+		{lang: i18n.Default, want: i18n.Translate(i18n.Default, "HISTORICO_EVENTOS_INDISPONIVEL")},
+		{lang: i18n.Negotiate("en"), want: "event history unavailable; reload the current state"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.lang), func(t *testing.T) {
+			handler := handlers.NewItemStreamHandler(truncatedStreamService{}, 0)
+			handler.Shutdown()
+
+			router := gin.New()
+			router.GET("/v1/items/stream", handler.Stream)
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/items/stream", nil)
+			req = req.WithContext(i18n.ContextWithLanguage(req.Context(), tt.lang))
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, req)
+
+			body := rec.Body.String()
+			if !strings.Contains(body, "event: reset\n") {
+				t.Fatalf("evento reset ausente: %q", body)
+			}
+			if !strings.Contains(body, `"codigo":"HISTORICO_EVENTOS_INDISPONIVEL"`) || !strings.Contains(body, tt.want) {
+				t.Fatalf("mensagem de reset = %q, esperado %q", body, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/response"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/input"
+	"github.com/gin-gonic/gin"
+)
+
+type PriceHandler struct {
+	priceService input.PriceService
+}
+
+func NewPriceHandler(priceService input.PriceService) *PriceHandler {
+	return &PriceHandler{
+		priceService: priceService,
+	}
+}
+
+type ScheduledPriceRequest struct {
+	Price    int64      `json:"price" binding:"required,gt=0"`
+	StartsAt time.Time  `json:"starts_at" binding:"required"`
+	EndsAt   *time.Time `json:"ends_at"`
+}
+
+func (h *PriceHandler) ListHistory(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	history, err := h.priceService.ListPriceHistory(c.Request.Context(), id, limit)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ItemResponse{
+		Sucesso: true,
+		Dados:   history,
+	})
+}
+
+func (h *PriceHandler) Schedule(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	var req ScheduledPriceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	schedule, err := h.priceService.SchedulePrice(c.Request.Context(), id, req.Price, req.StartsAt, req.EndsAt)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, ItemResponse{
+		Sucesso:  true,
+		Mensagem: response.Message(c, "PRECO_AGENDADO"),
+		Dados:    schedule,
+	})
+}
+
+func (h *PriceHandler) ListSchedules(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	schedules, err := h.priceService.ListScheduledPrices(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ItemResponse{
+		Sucesso: true,
+		Dados:   schedules,
+	})
+}
+
+func (h *PriceHandler) CancelSchedule(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	scheduleID, ok := parseIDParam(c, "scheduleId")
+	if !ok {
+		return
+	}
+
+	schedule, err := h.priceService.CancelScheduledPrice(c.Request.Context(), id, scheduleID)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ItemResponse{
+		Sucesso:  true,
+		Mensagem: response.Message(c, "AGENDAMENTO_PRECO_CANCELADO"),
+		Dados:    schedule,
+	})
+}
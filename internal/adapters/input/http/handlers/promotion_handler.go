@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/response"
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/input"
+	"github.com/gin-gonic/gin"
+)
+
+type PromotionHandler struct {
+	promotionService input.PromotionService
+}
+
+func NewPromotionHandler(promotionService input.PromotionService) *PromotionHandler {
+	return &PromotionHandler{
+		promotionService: promotionService,
+	}
+}
+
+type PromotionRequest struct {
+	Name         string              `json:"name" binding:"required,min=1"`
+	DiscountType domain.DiscountType `json:"discount_type" binding:"required"`
+	Value        int64               `json:"value" binding:"required,gt=0"`
+	ItemIDs      []int64             `json:"item_ids"`
+	Categories   []string            `json:"categories"`
+	Codes        []string            `json:"codes"`
+	Priority     int                 `json:"priority"`
+	Stackable    bool                `json:"stackable"`
+	StartsAt     *time.Time          `json:"starts_at"`
+	EndsAt       *time.Time          `json:"ends_at"`
+}
+
+func (h *PromotionHandler) Create(c *gin.Context) {
+	var req PromotionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	var startsAt time.Time
+	if req.StartsAt != nil {
+		startsAt = *req.StartsAt
+	}
+
+	promotion, err := h.promotionService.CreatePromotion(
+		c.Request.Context(),
+		req.Name,
+		req.DiscountType,
+		req.Value,
+		req.ItemIDs,
+		req.Categories,
+		req.Codes,
+		req.Priority,
+		req.Stackable,
+		startsAt,
+		req.EndsAt,
+	)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, ItemResponse{
+		Sucesso:  true,
+		Mensagem: response.Message(c, "PROMOCAO_CRIADA"),
+		Dados:    promotion,
+	})
+}
+
+func (h *PromotionHandler) List(c *gin.Context) {
+	promotions, err := h.promotionService.ListPromotions(c.Request.Context())
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ItemResponse{
+		Sucesso: true,
+		Dados:   promotions,
+	})
+}
+
+func (h *PromotionHandler) GetByID(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	promotion, err := h.promotionService.GetPromotion(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ItemResponse{
+		Sucesso: true,
+		Dados:   promotion,
+	})
+}
+
+func (h *PromotionHandler) Deactivate(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	promotion, err := h.promotionService.DeactivatePromotion(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ItemResponse{
+		Sucesso:  true,
+		Mensagem: response.Message(c, "PROMOCAO_DESATIVADA"),
+		Dados:    promotion,
+	})
+}
@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/response"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/input"
+	apiErrors "github.com/fesbarbosa/melivendas-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+type WebhookHandler struct {
+	webhookService input.WebhookService
+}
+
+func NewWebhookHandler(webhookService input.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+type WebhookRequest struct {
+	URL        string   `json:"url" binding:"required,url"`
+	EventTypes []string `json:"event_types" binding:"required,min=1"`
+	Secret     string   `json:"secret" binding:"omitempty,min=16"`
+}
+
+func parseIDParam(c *gin.Context, name string) (int64, bool) {
+	id, err := strconv.ParseInt(c.Param(name), 10, 64)
+	if err != nil {
+		response.ValidationError(c, []apiErrors.FieldError{apiErrors.NewFieldError(name, "DEVE_SER_INTEIRO")})
+		return 0, false
+	}
+	return id, true
+}
+
+func (h *WebhookHandler) Create(c *gin.Context) {
+	var req WebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BindingError(c, err)
+		return
+	}
+
+	subscription, secret, err := h.webhookService.CreateSubscription(c.Request.Context(), req.URL, req.EventTypes, req.Secret)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, ItemResponse{
+		Sucesso:  true,
+		Mensagem: response.Message(c, "WEBHOOK_CRIADO"),
+		Dados: gin.H{
+			"webhook": subscription,
+			"secret":  secret,
+		},
+	})
+}
+
+func (h *WebhookHandler) List(c *gin.Context) {
+	subscriptions, err := h.webhookService.ListSubscriptions(c.Request.Context())
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ItemResponse{
+		Sucesso: true,
+		Dados:   subscriptions,
+	})
+}
+
+func (h *WebhookHandler) Delete(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.webhookService.DeleteSubscription(c.Request.Context(), id); err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ItemResponse{
+		Sucesso:  true,
+		Mensagem: response.Message(c, "WEBHOOK_EXCLUIDO"),
+	})
+}
+
+func (h *WebhookHandler) Enable(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	subscription, err := h.webhookService.EnableSubscription(c.Request.Context(), id)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ItemResponse{
+		Sucesso:  true,
+		Mensagem: response.Message(c, "WEBHOOK_REATIVADO"),
+		Dados:    subscription,
+	})
+}
+
+func (h *WebhookHandler) ListDeliveries(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	deliveries, err := h.webhookService.ListDeliveries(c.Request.Context(), id, limit)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, ItemResponse{
+		Sucesso: true,
+		Dados:   deliveries,
+	})
+}
+
+func (h *WebhookHandler) Redeliver(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+
+	deliveryID, ok := parseIDParam(c, "deliveryId")
+	if !ok {
+		return
+	}
+
+	delivery, err := h.webhookService.Redeliver(c.Request.Context(), id, deliveryID)
+	if err != nil {
+		response.Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, ItemResponse{
+		Sucesso:  true,
+		Mensagem: response.Message(c, "REENVIO_AGENDADO"),
+		Dados:    delivery,
+	})
+}
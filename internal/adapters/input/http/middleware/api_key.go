@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/response"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/input"
+	"github.com/gin-gonic/gin"
+)
+
+const APIKeyHeader = "X-API-Key"
+
+func APIKeyAuth(apiKeyService input.APIKeyService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rawKey := c.GetHeader(APIKeyHeader)
+		if rawKey == "" {
+			c.Next()
+			return
+		}
+
+		principal, err := apiKeyService.AuthenticateAPIKey(c.Request.Context(), rawKey)
+		if err != nil {
+			response.Error(c, err)
+			return
+		}
+
+		setPrincipal(c, principal)
+		c.Next()
+	}
+}
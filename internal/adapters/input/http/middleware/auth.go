@@ -0,0 +1,184 @@
+package middleware
+
+import (
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/response"
+	"github.com/fesbarbosa/melivendas-api/internal/config"
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	apiErrors "github.com/fesbarbosa/melivendas-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const PrincipalKey = "principal"
+
+type tokenClaims struct {
+	jwt.RegisteredClaims
+	Name     string   `json:"name,omitempty"`
+	SellerID string   `json:"seller_id,omitempty"`
+	Roles    []string `json:"roles,omitempty"`
+}
+
+type JWTAuthenticator struct {
+	enabled      bool
+	anonymous    *domain.Principal
+	hmacSecret   []byte
+	rsaKeys      map[string]*rsa.PublicKey
+	parser       *jwt.Parser
+	publicRoutes *RouteMatcher
+}
+
+func NewJWTAuthenticator(cfg *config.AuthConfig) (*JWTAuthenticator, error) {
+	auth := &JWTAuthenticator{
+		enabled:      cfg.Enabled,
+		publicRoutes: NewRouteMatcher(cfg.PublicRoutes),
+	}
+
+	if !cfg.Enabled {
+		auth.anonymous = &domain.Principal{
+			Subject:  "anonymous",
+			SellerID: cfg.DefaultSellerID,
+		}
+		return auth, nil
+	}
+
+	var methods []string
+
+	if cfg.HMACSecret != "" {
+		auth.hmacSecret = []byte(cfg.HMACSecret)
+		methods = append(methods, jwt.SigningMethodHS256.Alg())
+	}
+
+	if cfg.JWKSFile != "" {
+		keys, err := loadJWKS(cfg.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		auth.rsaKeys = keys
+		methods = append(methods, jwt.SigningMethodRS256.Alg())
+	}
+
+	if len(methods) == 0 {
+		return nil, errors.New("authentication enabled but neither AUTH_JWT_SECRET nor AUTH_JWKS_FILE is configured")
+	}
+
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+	}
+	if cfg.Issuer != "" {
+		options = append(options, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		options = append(options, jwt.WithAudience(cfg.Audience))
+	}
+	auth.parser = jwt.NewParser(options...)
+
+	return auth, nil
+}
+
+func (a *JWTAuthenticator) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := domain.PrincipalFromContext(c.Request.Context()); ok {
+			c.Next()
+			return
+		}
+
+		if a.enabled && a.publicRoutes.Match(c.Request.Method, c.FullPath()) {
+			c.Next()
+			return
+		}
+
+		principal, err := a.Authenticate(c.GetHeader("Authorization"))
+		if err != nil {
+			abortUnauthorized(c, err)
+			return
+		}
+
+		setPrincipal(c, principal)
+		c.Next()
+	}
+}
+
+func (a *JWTAuthenticator) Authenticate(authorization string) (*domain.Principal, error) {
+	if !a.enabled {
+		return a.anonymous, nil
+	}
+
+	tokenString, err := bearerToken(authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	principal, err := a.authenticate(tokenString)
+	if err != nil {
+		return nil, apiErrors.Coded(apiErrors.ErrUnauthorized, "TOKEN_INVALIDO")
+	}
+
+	return principal, nil
+}
+
+func (a *JWTAuthenticator) authenticate(tokenString string) (*domain.Principal, error) {
+	claims := &tokenClaims{}
+	_, err := a.parser.ParseWithClaims(tokenString, claims, a.keyFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Subject == "" {
+		return nil, errors.New("token without subject")
+	}
+
+	return &domain.Principal{
+		Subject:  claims.Subject,
+		Name:     claims.Name,
+		SellerID: claims.SellerID,
+		Roles:    claims.Roles,
+	}, nil
+}
+
+func (a *JWTAuthenticator) keyFunc(token *jwt.Token) (interface{}, error) {
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		if a.hmacSecret == nil {
+			return nil, errors.New("HMAC tokens are not accepted")
+		}
+		return a.hmacSecret, nil
+	case *jwt.SigningMethodRSA:
+		kid, _ := token.Header["kid"].(string)
+		key, ok := a.rsaKeys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown key id %q", kid)
+		}
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+	}
+}
+
+func bearerToken(header string) (string, error) {
+	if header == "" {
+		return "", apiErrors.Coded(apiErrors.ErrUnauthorized, "TOKEN_AUSENTE")
+	}
+
+	scheme, token, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", apiErrors.Coded(apiErrors.ErrUnauthorized, "ESQUEMA_AUTORIZACAO_INVALIDO")
+	}
+
+	return strings.TrimSpace(token), nil
+}
+
+func setPrincipal(c *gin.Context, principal *domain.Principal) {
+	c.Set(PrincipalKey, principal)
+	c.Request = c.Request.WithContext(domain.ContextWithPrincipal(c.Request.Context(), principal))
+}
+
+func abortUnauthorized(c *gin.Context, err error) {
+	c.Header("WWW-Authenticate", `Bearer realm="melivendas-api"`)
+	response.Error(c, err)
+}
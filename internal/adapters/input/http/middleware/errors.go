@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/response"
+	apiErrors "github.com/fesbarbosa/melivendas-api/pkg/errors"
+	"github.com/fesbarbosa/melivendas-api/pkg/i18n"
+	"github.com/gin-gonic/gin"
+)
+
+func ProblemDetails() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(response.ProblemJSONKey, true)
+		c.Next()
+	}
+}
+
+func Recovery() gin.HandlerFunc {
+	return gin.CustomRecovery(func(c *gin.Context, recovered interface{}) {
+		response.Error(c, fmt.Errorf("panic: %v", recovered))
+	})
+}
+
+func NoRoute(c *gin.Context) {
+	response.Error(c, apiErrors.Coded(apiErrors.ErrNotFound, "ROTA_NAO_ENCONTRADA"))
+}
+
+func NoMethod(c *gin.Context) {
+	response.APIError(c, &apiErrors.APIError{
+		Status:   http.StatusMethodNotAllowed,
+		Codigo:   "METODO_NAO_PERMITIDO",
+		Mensagem: i18n.Translate(i18n.Default, "METODO_NAO_PERMITIDO"),
+	})
+}
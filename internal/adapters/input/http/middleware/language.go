@@ -0,0 +1,18 @@
+package middleware
+
+import (
+	"github.com/fesbarbosa/melivendas-api/pkg/i18n"
+	"github.com/gin-gonic/gin"
+)
+
+func Language() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		lang := i18n.Negotiate(c.GetHeader("Accept-Language"))
+
+		c.Header("Content-Language", string(lang))
+		c.Writer.Header().Add("Vary", "Accept-Language")
+		c.Request = c.Request.WithContext(i18n.ContextWithLanguage(c.Request.Context(), lang))
+
+		c.Next()
+	}
+}
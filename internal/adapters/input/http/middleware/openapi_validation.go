@@ -0,0 +1,207 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/openapi"
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/response"
+	apiErrors "github.com/fesbarbosa/melivendas-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+var routeParam = regexp.MustCompile(`:([A-Za-z0-9_]+)`)
+
+type OpenAPIValidator struct {
+	document          *openapi.Document
+	operations        map[string]*openapi.Operation
+	validateResponses bool
+}
+
+func NewOpenAPIValidator(document *openapi.Document, validateResponses bool) *OpenAPIValidator {
+	operations := make(map[string]*openapi.Operation)
+	for key, operation := range document.Operations() {
+		method, path, _ := strings.Cut(key, " ")
+		operations[strings.ToUpper(method)+" "+path] = operation
+	}
+
+	return &OpenAPIValidator{
+		document:          document,
+		operations:        operations,
+		validateResponses: validateResponses,
+	}
+}
+
+func (v *OpenAPIValidator) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if route == "" {
+			c.Next()
+			return
+		}
+
+		operation, ok := v.operations[c.Request.Method+" "+routeParam.ReplaceAllString(route, "{$1}")]
+		if !ok {
+			c.Next()
+			return
+		}
+
+		if violations := v.validateRequest(c, operation); len(violations) > 0 {
+			response.ValidationError(c, violations)
+			return
+		}
+
+		if !v.validateResponses || streamsResponse(operation) {
+			c.Next()
+			return
+		}
+
+		original := c.Writer
+		recorder := &responseRecorder{ResponseWriter: original, status: http.StatusOK}
+		c.Writer = recorder
+
+		c.Next()
+
+		c.Writer = original
+
+		if violations := v.validateResponse(operation, recorder); len(violations) > 0 {
+			slog.ErrorContext(c.Request.Context(), "resposta não corresponde à especificação OpenAPI",
+				"method", c.Request.Method,
+				"route", route,
+				"status", recorder.status,
+				"violations", violations,
+			)
+			apiErr := apiErrors.NewAPIError(apiErrors.Coded(apiErrors.ErrInternalServer, "RESPOSTA_FORA_DA_ESPECIFICACAO"))
+			apiErr.Campos = violations
+			response.APIError(c, apiErr)
+			return
+		}
+
+		original.WriteHeader(recorder.status)
+		_, _ = original.Write(recorder.body.Bytes())
+	}
+}
+
+func (v *OpenAPIValidator) validateRequest(c *gin.Context, operation *openapi.Operation) []apiErrors.FieldError {
+	var violations []apiErrors.FieldError
+
+	for _, parameter := range operation.Parameters {
+		var raw string
+		var present bool
+
+		switch parameter.In {
+		case "path":
+			raw = c.Param(parameter.Name)
+			present = true
+		case "query":
+			raw, present = c.GetQuery(parameter.Name)
+		case "header":
+			raw = c.GetHeader(parameter.Name)
+			present = raw != ""
+		}
+
+		violations = append(violations, v.document.ValidateParameter(parameter, raw, present)...)
+	}
+
+	if operation.RequestBody != nil {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			return append(violations, apiErrors.NewFieldError("body", "FALHA_LEITURA_CORPO"))
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		switch {
+		case len(bytes.TrimSpace(body)) == 0:
+			if operation.RequestBody.Required {
+				violations = append(violations, apiErrors.NewFieldError("body", "CORPO_OBRIGATORIO"))
+			}
+		default:
+			media, ok := operation.RequestBody.Content[c.ContentType()]
+			if !ok {
+				violations = append(violations, apiErrors.NewFieldError("Content-Type", "CONTENT_TYPE_INVALIDO"))
+				break
+			}
+			violations = append(violations, v.document.ValidateJSON(body, media.Schema)...)
+		}
+	}
+
+	sortViolations(violations)
+	return violations
+}
+
+func (v *OpenAPIValidator) validateResponse(operation *openapi.Operation, recorder *responseRecorder) []apiErrors.FieldError {
+	documented, ok := operation.Responses[strconv.Itoa(recorder.status)]
+	if !ok {
+		return []apiErrors.FieldError{apiErrors.NewFieldError("status", "STATUS_NAO_DOCUMENTADO", recorder.status)}
+	}
+
+	if len(documented.Content) == 0 || recorder.body.Len() == 0 {
+		return nil
+	}
+
+	contentType, _, _ := strings.Cut(recorder.Header().Get("Content-Type"), ";")
+	media, ok := documented.Content[strings.TrimSpace(contentType)]
+	if !ok {
+		return []apiErrors.FieldError{apiErrors.NewFieldError("Content-Type", "CONTENT_TYPE_NAO_DOCUMENTADO", contentType)}
+	}
+	if media.Schema == nil {
+		return nil
+	}
+
+	violations := v.document.ValidateJSON(recorder.body.Bytes(), media.Schema)
+	sortViolations(violations)
+	return violations
+}
+
+func streamsResponse(operation *openapi.Operation) bool {
+	for _, documented := range operation.Responses {
+		if _, ok := documented.Content["text/event-stream"]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func sortViolations(violations []apiErrors.FieldError) {
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Campo < violations[j].Campo
+	})
+}
+
+type responseRecorder struct {
+	gin.ResponseWriter
+	body   bytes.Buffer
+	status int
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+}
+
+func (r *responseRecorder) WriteHeaderNow() {}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	return r.body.Write(data)
+}
+
+func (r *responseRecorder) WriteString(s string) (int, error) {
+	return r.body.WriteString(s)
+}
+
+func (r *responseRecorder) Status() int {
+	return r.status
+}
+
+func (r *responseRecorder) Size() int {
+	return r.body.Len()
+}
+
+func (r *responseRecorder) Written() bool {
+	return r.body.Len() > 0
+}
@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/response"
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+	apiErrors "github.com/fesbarbosa/melivendas-api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+const rateLimitResultKey = "rate_limit_result"
+
+type RateLimiter struct {
+	store      output.RateLimitStore
+	readLimit  output.RateLimit
+	writeLimit output.RateLimit
+	ipLimit    output.RateLimit
+}
+
+func NewRateLimiter(store output.RateLimitStore, readLimit, writeLimit, ipLimit output.RateLimit) *RateLimiter {
+	return &RateLimiter{
+		store:      store,
+		readLimit:  readLimit,
+		writeLimit: writeLimit,
+		ipLimit:    ipLimit,
+	}
+}
+
+func (l *RateLimiter) ClientIPMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		l.take(c, "client-ip:"+c.ClientIP(), l.ipLimit)
+	}
+}
+
+func (l *RateLimiter) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		limit, class := l.writeLimit, "write"
+		if isReadMethod(c.Request.Method) {
+			limit, class = l.readLimit, "read"
+		}
+
+		l.take(c, rateLimitKey(c)+":"+class, limit)
+	}
+}
+
+func (l *RateLimiter) take(c *gin.Context, key string, limit output.RateLimit) {
+	result, err := l.store.Take(c.Request.Context(), key, limit)
+	if err != nil {
+		slog.WarnContext(c.Request.Context(), "falha ao aplicar limite de requisições", "key", key, "error", err)
+		c.Next()
+		return
+	}
+
+	if mostRestrictive(c, result) {
+		c.Set(rateLimitResultKey, result)
+		c.Header("RateLimit-Limit", strconv.Itoa(result.Limit))
+		c.Header("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		c.Header("RateLimit-Reset", strconv.Itoa(ceilSeconds(result.ResetAfter)))
+	}
+
+	if !result.Allowed {
+		c.Header("Retry-After", strconv.Itoa(ceilSeconds(result.RetryAfter)))
+		response.Error(c, apiErrors.Coded(apiErrors.ErrTooManyRequests, "LIMITE_REQUISICOES_EXCEDIDO", ceilSeconds(result.RetryAfter)))
+		return
+	}
+
+	c.Next()
+}
+
+func mostRestrictive(c *gin.Context, result *output.RateLimitResult) bool {
+	previous, ok := c.Get(rateLimitResultKey)
+	if !ok || !result.Allowed {
+		return true
+	}
+	return result.Remaining < previous.(*output.RateLimitResult).Remaining
+}
+
+func rateLimitKey(c *gin.Context) string {
+	if principal, ok := domain.PrincipalFromContext(c.Request.Context()); ok && principal.Subject != "anonymous" {
+		return "principal:" + principal.Subject
+	}
+	return "ip:" + c.ClientIP()
+}
+
+func isReadMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/output/ratelimit"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+	"github.com/gin-gonic/gin"
+)
+
+func newRateLimitedRouter(t *testing.T, trustedProxies []string) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	limit := output.RateLimit{Rate: 0.001, Burst: 1}
+	limiter := NewRateLimiter(ratelimit.NewMemoryStore(), limit, limit, limit)
+
+	router := gin.New()
+	if err := router.SetTrustedProxies(trustedProxies); err != nil {
+		t.Fatalf("falha ao configurar proxies: %v", err)
+	}
+	router.Use(limiter.ClientIPMiddleware())
+	router.Use(func(c *gin.Context) {
+		c.AbortWithStatus(http.StatusUnauthorized)
+	})
+	router.GET("/items", func(c *gin.Context) {})
+	return router
+}
+
+func TestClientIPLimitAppliesBeforeAuthentication(t *testing.T) {
+	tests := []struct {
+		name           string
+		trustedProxies []string
+		wantSecond     int
+	}{
+		{name: "cabeçalho forjado é ignorado sem proxies confiáveis", trustedProxies: nil, wantSecond: http.StatusTooManyRequests},
+		{name: "proxy confiável repassa o IP do cliente", trustedProxies: []string{"192.0.2.1"}, wantSecond: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newRateLimitedRouter(t, tt.trustedProxies)
+
+			statuses := make([]int, 0, 2)
+			for i := 0; i < 2; i++ {
+				req := httptest.NewRequest(http.MethodGet, "/items", nil)
+				req.RemoteAddr = "192.0.2.1:1234"
+				req.Header.Set("X-Forwarded-For", "203.0.113."+strconv.Itoa(i+1))
+				rec := httptest.NewRecorder()
+				router.ServeHTTP(rec, req)
+				statuses = append(statuses, rec.Code)
+			}
+
+			if statuses[0] != http.StatusUnauthorized {
+				t.Fatalf("primeira requisição: esperado %d, obtido %d", http.StatusUnauthorized, statuses[0])
+			}
+			if statuses[1] != tt.wantSecond {
+				t.Fatalf("segunda requisição: esperado %d, obtido %d", tt.wantSecond, statuses[1])
+			}
+		})
+	}
+}
+
+func TestRateLimitHeadersReportTheMostRestrictiveLimit(t *testing.T) {
+	tests := []struct {
+		name           string
+		ipBurst        int
+		principalBurst int
+		wantLimit      string
+		wantRemaining  string
+	}{
+		{name: "limite por IP mais próximo do fim", ipBurst: 2, principalBurst: 10, wantLimit: "2", wantRemaining: "1"},
+		{name: "limite por principal mais próximo do fim", ipBurst: 10, principalBurst: 3, wantLimit: "3", wantRemaining: "2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			principalLimit := output.RateLimit{Rate: 0.001, Burst: tt.principalBurst}
+			limiter := NewRateLimiter(ratelimit.NewMemoryStore(), principalLimit, principalLimit, output.RateLimit{Rate: 0.001, Burst: tt.ipBurst})
+
+			router := gin.New()
+			router.Use(limiter.ClientIPMiddleware(), limiter.Middleware())
+			router.GET("/items", func(c *gin.Context) {})
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/items", nil))
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, esperado %d", rec.Code, http.StatusOK)
+			}
+			if got := rec.Header().Get("RateLimit-Limit"); got != tt.wantLimit {
+				t.Errorf("RateLimit-Limit = %s, esperado %s", got, tt.wantLimit)
+			}
+			if got := rec.Header().Get("RateLimit-Remaining"); got != tt.wantRemaining {
+				t.Errorf("RateLimit-Remaining = %s, esperado %s", got, tt.wantRemaining)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"strings"
+)
+
+type RouteMatcher struct {
+	routes map[string]struct{}
+}
+
+func NewRouteMatcher(routes []string) *RouteMatcher {
+	matcher := &RouteMatcher{
+		routes: make(map[string]struct{}, len(routes)),
+	}
+
+	for _, route := range routes {
+		method, path, found := strings.Cut(strings.TrimSpace(route), " ")
+		if !found {
+			matcher.routes["* "+method] = struct{}{}
+			continue
+		}
+		matcher.routes[strings.ToUpper(method)+" "+strings.TrimSpace(path)] = struct{}{}
+	}
+
+	return matcher
+}
+
+func (m *RouteMatcher) Match(method, path string) bool {
+	if path == "" {
+		return false
+	}
+
+	if _, ok := m.routes["* "+path]; ok {
+		return true
+	}
+
+	_, ok := m.routes[method+" "+path]
+	return ok
+}
@@ -0,0 +1,8 @@
+package openapi
+
+import (
+	_ "embed"
+)
+
+//go:embed docs.html
+var DocsPage []byte
@@ -0,0 +1,146 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/openapi"
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/routes"
+	"github.com/gin-gonic/gin"
+)
+
+var undocumentedRoutes = map[string]bool{
+	"GET /metrics":      true,
+	"GET /openapi.json": true,
+	"GET /docs":         true,
+}
+
+var pathParam = regexp.MustCompile(`:([A-Za-z0-9_]+)`)
+
+func registeredRoutes(t *testing.T) map[string]bool {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	routes.Register(router, routes.Handlers{
+		Metrics: http.NotFoundHandler(),
+		OpenAPI: openapi.Build(),
+	})
+
+	registered := make(map[string]bool)
+	for _, route := range router.Routes() {
+		key := route.Method + " " + pathParam.ReplaceAllString(route.Path, "{$1}")
+		if !undocumentedRoutes[key] {
+			registered[key] = true
+		}
+	}
+	return registered
+}
+
+func TestSpecMatchesRegisteredRoutes(t *testing.T) {
+	registered := registeredRoutes(t)
+
+	documented := make(map[string]bool)
+	for key := range openapi.Build().Operations() {
+		method, path, _ := strings.Cut(key, " ")
+		documented[strings.ToUpper(method)+" "+path] = true
+	}
+
+	var missing, stale []string
+	for key := range registered {
+		if !documented[key] {
+			missing = append(missing, key)
+		}
+	}
+	for key := range documented {
+		if !registered[key] {
+			stale = append(stale, key)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(stale)
+
+	for _, key := range missing {
+		t.Errorf("rota registrada sem documentação no OpenAPI: %s", key)
+	}
+	for _, key := range stale {
+		t.Errorf("operação documentada sem rota registrada: %s", key)
+	}
+}
+
+func TestSpecReferencesResolve(t *testing.T) {
+	document := openapi.Build()
+
+	raw, err := json.Marshal(document)
+	if err != nil {
+		t.Fatalf("falha ao serializar documento: %v", err)
+	}
+
+	refs := regexp.MustCompile(`"\$ref":"#/components/schemas/([^"]+)"`).FindAllStringSubmatch(string(raw), -1)
+	if len(refs) == 0 {
+		t.Fatal("documento sem referências a schemas")
+	}
+
+	for _, ref := range refs {
+		if _, ok := document.Components.Schemas[ref[1]]; !ok {
+			t.Errorf("schema referenciado não existe: %s", ref[1])
+		}
+	}
+}
+
+func TestSpecPathParametersAreDeclared(t *testing.T) {
+	placeholder := regexp.MustCompile(`\{([^}]+)\}`)
+
+	for key, operation := range openapi.Build().Operations() {
+		_, path, _ := strings.Cut(key, " ")
+
+		declared := make(map[string]bool)
+		for _, parameter := range operation.Parameters {
+			if parameter.In == "path" {
+				declared[parameter.Name] = true
+			}
+		}
+
+		for _, match := range placeholder.FindAllStringSubmatch(path, -1) {
+			if !declared[match[1]] {
+				t.Errorf("%s: parâmetro de caminho %q não declarado", key, match[1])
+			}
+		}
+	}
+}
+
+func TestItemRequestAcceptsZeroStockButRequiresIt(t *testing.T) {
+	document := openapi.Build()
+	schema := document.Paths["/v1/items"]["post"].RequestBody.Content["application/json"].Schema
+
+	cases := []struct {
+		name   string
+		body   string
+		fields []string
+	}{
+		{"estoque zero", `{"code":"A","title":"t","description":"d","price":10,"stock":0}`, nil},
+		{"estoque ausente", `{"code":"A","title":"t","description":"d","price":10}`, []string{"stock"}},
+		{"preço zero e estoque negativo", `{"code":"A","title":"t","description":"d","price":0,"stock":-1}`, []string{"price", "stock"}},
+		{"código vazio", `{"code":"","title":"t","description":"d","price":10,"stock":1}`, []string{"code"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			violations := document.ValidateJSON([]byte(tc.body), schema)
+
+			var fields []string
+			for _, violation := range violations {
+				fields = append(fields, violation.Campo)
+			}
+			sort.Strings(fields)
+
+			if strings.Join(fields, ",") != strings.Join(tc.fields, ",") {
+				t.Errorf("campos inválidos = %v, esperado %v", fields, tc.fields)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package openapi
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+type schemaRegistry struct {
+	schemas map[string]*Schema
+	enums   map[reflect.Type][]interface{}
+}
+
+func newSchemaRegistry() *schemaRegistry {
+	return &schemaRegistry{
+		schemas: make(map[string]*Schema),
+		enums:   make(map[reflect.Type][]interface{}),
+	}
+}
+
+func (r *schemaRegistry) enum(value interface{}, values ...interface{}) {
+	r.enums[reflect.TypeOf(value)] = values
+}
+
+func (r *schemaRegistry) ref(value interface{}) *Schema {
+	return r.schemaFor(reflect.TypeOf(value))
+}
+
+func (r *schemaRegistry) schemaFor(t reflect.Type) *Schema {
+	if t.Kind() == reflect.Pointer {
+		schema := r.schemaFor(t.Elem())
+		if schema.Ref != "" {
+			return &Schema{AllOf: []*Schema{schema}, Nullable: true}
+		}
+		schema.Nullable = true
+		return schema
+	}
+
+	if t == timeType {
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+
+	if values, ok := r.enums[t]; ok {
+		return &Schema{Type: "string", Enum: values}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: r.schemaFor(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: r.schemaFor(t.Elem())}
+	case reflect.Interface:
+		return &Schema{}
+	case reflect.Struct:
+		return r.structRef(t)
+	default:
+		return &Schema{}
+	}
+}
+
+func (r *schemaRegistry) structRef(t reflect.Type) *Schema {
+	name := t.Name()
+	ref := &Schema{Ref: "#/components/schemas/" + name}
+
+	if _, ok := r.schemas[name]; ok {
+		return ref
+	}
+
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	r.schemas[name] = schema
+
+	r.addFields(schema, t, hasValidation(t))
+
+	return ref
+}
+
+func (r *schemaRegistry) addFields(schema *Schema, t reflect.Type, validated bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		if field.Anonymous && field.Type.Kind() == reflect.Struct && field.Tag.Get("json") == "" {
+			r.addFields(schema, field.Type, validated)
+			continue
+		}
+
+		name, omitempty := jsonName(field)
+		if name == "-" {
+			continue
+		}
+
+		property := r.schemaFor(field.Type)
+		required := applyValidation(property, field)
+		if required || (!validated && !omitempty && field.Type.Kind() != reflect.Pointer) {
+			schema.Required = append(schema.Required, name)
+		}
+
+		schema.Properties[name] = property
+	}
+}
+
+func hasValidation(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Tag.Get("binding") != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "" {
+		return field.Name, false
+	}
+
+	name, options, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+
+	return name, strings.Contains(options, "omitempty")
+}
+
+func applyValidation(schema *Schema, field reflect.StructField) bool {
+	rules := field.Tag.Get("binding")
+	if rules == "" {
+		return false
+	}
+
+	required := false
+	for _, rule := range strings.Split(rules, ",") {
+		name, param, _ := strings.Cut(rule, "=")
+		switch name {
+		case "required":
+			required = true
+		case "url":
+			schema.Format = "uri"
+		case "gt":
+			if value, err := strconv.ParseFloat(param, 64); err == nil {
+				minimum := value + 1
+				schema.Minimum = &minimum
+			}
+		case "gte":
+			if value, err := strconv.ParseFloat(param, 64); err == nil {
+				schema.Minimum = &value
+			}
+		case "min":
+			if value, err := strconv.Atoi(param); err == nil {
+				if schema.Type == "array" {
+					schema.MinItems = &value
+				} else {
+					schema.MinLength = &value
+				}
+			}
+		}
+	}
+
+	if required && field.Type.Kind() == reflect.String && schema.MinLength == nil {
+		minLength := 1
+		schema.MinLength = &minLength
+	}
+
+	return required
+}
@@ -0,0 +1,665 @@
+package openapi
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/handlers"
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	apiErrors "github.com/fesbarbosa/melivendas-api/pkg/errors"
+)
+
+type WebhookCreated struct {
+	Webhook domain.WebhookSubscription `json:"webhook"`
+	Secret  string                     `json:"secret"`
+}
+
+type builder struct {
+	doc     *Document
+	schemas *schemaRegistry
+}
+
+func Build() *Document {
+	schemas := newSchemaRegistry()
+	schemas.enum(domain.ItemStatus(""), string(domain.ItemStatusActive), string(domain.ItemStatusInactive))
+	schemas.enum(domain.EventType(""),
+		string(domain.EventItemCreated),
+		string(domain.EventItemUpdated),
+		string(domain.EventStockChanged),
+		string(domain.EventItemStatusChanged),
+		string(domain.EventItemDeleted),
+	)
+	schemas.enum(domain.PriceChangeSource(""),
+		string(domain.PriceChangeManual),
+		string(domain.PriceChangeScheduled),
+		string(domain.PriceChangeScheduleEnded),
+	)
+	schemas.enum(domain.ScheduledPriceStatus(""),
+		string(domain.ScheduledPricePending),
+		string(domain.ScheduledPriceActive),
+		string(domain.ScheduledPriceCompleted),
+		string(domain.ScheduledPriceCancelled),
+	)
+	schemas.enum(domain.DiscountType(""), string(domain.DiscountPercentage), string(domain.DiscountFixed))
+	schemas.enum(domain.WebhookDeliveryStatus(""),
+		string(domain.WebhookDeliveryPending),
+		string(domain.WebhookDeliverySucceeded),
+		string(domain.WebhookDeliveryFailed),
+	)
+
+	b := &builder{
+		doc: &Document{
+			OpenAPI: "3.0.3",
+			Info: Info{
+				Title:       "Melivendas API",
+				Description: "API de gerenciamento de itens, chaves de API e webhooks.",
+				Version:     "1.0.0",
+			},
+			Paths: make(map[string]PathItem),
+			Security: []SecurityRequirement{
+				{"bearerAuth": {}},
+				{"apiKeyAuth": {}},
+			},
+			Tags: []Tag{
+				{Name: "Itens", Description: "Cadastro e consulta de itens"},
+				{Name: "Preços", Description: "Histórico e agendamento de preços"},
+				{Name: "Promoções", Description: "Descontos promocionais e preço efetivo dos itens"},
+				{Name: "Chaves de API", Description: "Gerenciamento de chaves de API"},
+				{Name: "Webhooks", Description: "Assinaturas e entregas de webhooks"},
+				{Name: "GraphQL", Description: "Consultas e mutações GraphQL"},
+				{Name: "Saúde", Description: "Verificações de saúde do serviço"},
+			},
+		},
+		schemas: schemas,
+	}
+
+	b.itemOperations()
+	b.priceOperations()
+	b.promotionOperations()
+	b.apiKeyOperations()
+	b.webhookOperations()
+	b.graphQLOperations()
+	b.healthOperations()
+
+	b.doc.Components = Components{
+		Schemas: schemas.schemas,
+		SecuritySchemes: map[string]*SecurityScheme{
+			"bearerAuth": {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			"apiKeyAuth": {Type: "apiKey", In: "header", Name: "X-API-Key"},
+		},
+	}
+
+	return b.doc
+}
+
+func (b *builder) add(method, path string, operation *Operation) {
+	item, ok := b.doc.Paths[path]
+	if !ok {
+		item = make(PathItem)
+		b.doc.Paths[path] = item
+	}
+
+	if operation.Security == nil {
+		operation.Responses["401"] = b.errorResponse("Não autenticado")
+		operation.Responses["429"] = b.errorResponse("Limite de requisições excedido")
+	}
+	if _, ok := operation.Responses["500"]; !ok {
+		operation.Responses["500"] = b.errorResponse("Erro interno do servidor")
+	}
+
+	item[strings.ToLower(method)] = operation
+}
+
+func (b *builder) envelope(dados *Schema) *Schema {
+	schema := b.schemas.ref(handlers.ItemResponse{})
+	if dados == nil {
+		return schema
+	}
+
+	return &Schema{
+		AllOf: []*Schema{
+			schema,
+			{Type: "object", Properties: map[string]*Schema{"dados": dados}},
+		},
+	}
+}
+
+func (b *builder) jsonResponse(description string, schema *Schema) *Response {
+	return &Response{
+		Description: description,
+		Content:     map[string]MediaType{"application/json": {Schema: schema}},
+	}
+}
+
+func (b *builder) errorResponse(description string) *Response {
+	return &Response{
+		Description: description,
+		Content: map[string]MediaType{
+			"application/json":         {Schema: b.schemas.ref(apiErrors.APIError{})},
+			"application/problem+json": {Schema: b.schemas.ref(apiErrors.Problem{})},
+		},
+	}
+}
+
+func (b *builder) jsonBody(value interface{}, required bool) *RequestBody {
+	return &RequestBody{
+		Required: required,
+		Content:  map[string]MediaType{"application/json": {Schema: b.schemas.ref(value)}},
+	}
+}
+
+func idParam(name, description string) Parameter {
+	return Parameter{
+		Name:        name,
+		In:          "path",
+		Description: description,
+		Required:    true,
+		Schema:      &Schema{Type: "integer", Format: "int64"},
+	}
+}
+
+func codeParam() Parameter {
+	return Parameter{
+		Name:        "code",
+		In:          "path",
+		Description: "Código do item",
+		Required:    true,
+		Schema:      &Schema{Type: "string"},
+	}
+}
+
+func queryParam(name, description string, schema *Schema) Parameter {
+	return Parameter{Name: name, In: "query", Description: description, Schema: schema}
+}
+
+func conditionalParams(lastModified bool) []Parameter {
+	params := []Parameter{
+		{Name: "If-None-Match", In: "header", Description: "ETag obtido em uma resposta anterior", Schema: &Schema{Type: "string"}},
+	}
+	if lastModified {
+		params = append(params, Parameter{Name: "If-Modified-Since", In: "header", Description: "Data HTTP obtida do cabeçalho Last-Modified", Schema: &Schema{Type: "string"}})
+	}
+	return params
+}
+
+func (b *builder) cacheableResponse(description string, schema *Schema, lastModified bool) *Response {
+	response := b.jsonResponse(description, schema)
+	response.Headers = validatorHeaders(lastModified)
+	return response
+}
+
+func notModifiedResponse(lastModified bool) *Response {
+	return &Response{
+		Description: "Representação não modificada desde a última consulta",
+		Headers:     validatorHeaders(lastModified),
+	}
+}
+
+func validatorHeaders(lastModified bool) map[string]Header {
+	headers := map[string]Header{
+		"ETag":          {Description: "Identificador da versão da representação", Schema: &Schema{Type: "string"}},
+		"Cache-Control": {Description: "Diretivas de cache configuradas", Schema: &Schema{Type: "string"}},
+	}
+	if lastModified {
+		headers["Last-Modified"] = Header{Description: "Data da última alteração", Schema: &Schema{Type: "string"}}
+	}
+	return headers
+}
+
+func responses(entries ...interface{}) map[string]*Response {
+	result := make(map[string]*Response, len(entries)/2)
+	for i := 0; i+1 < len(entries); i += 2 {
+		result[strconv.Itoa(entries[i].(int))] = entries[i+1].(*Response)
+	}
+	return result
+}
+
+func (b *builder) itemOperations() {
+	item := b.schemas.ref(domain.PricedItem{})
+	tags := []string{"Itens"}
+
+	b.add(http.MethodPost, "/v1/items", &Operation{
+		OperationID: "createItem",
+		Summary:     "Cria um item",
+		Tags:        tags,
+		RequestBody: b.jsonBody(handlers.ItemRequest{}, true),
+		Responses: responses(
+			http.StatusCreated, b.jsonResponse("Item criado", b.envelope(item)),
+			http.StatusBadRequest, b.errorResponse("Requisição inválida"),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+			http.StatusConflict, b.errorResponse("Código já utilizado"),
+		),
+	})
+
+	b.add(http.MethodGet, "/v1/items", &Operation{
+		OperationID: "listItems",
+		Summary:     "Lista itens paginados",
+		Tags:        tags,
+		Parameters: append([]Parameter{
+			queryParam("status", "Filtra pelo status do item", b.schemas.ref(domain.ItemStatus(""))),
+			queryParam("limit", "Itens por página (máximo 20)", &Schema{Type: "integer", Format: "int32"}),
+			queryParam("page", "Página, a partir de 1", &Schema{Type: "integer", Format: "int32"}),
+		}, conditionalParams(false)...),
+		Responses: responses(
+			http.StatusOK, b.cacheableResponse("Página de itens", b.schemas.ref(handlers.PagedItems{}), false),
+			http.StatusNotModified, notModifiedResponse(false),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+		),
+	})
+
+	b.add(http.MethodGet, "/v1/items/stream", &Operation{
+		OperationID: "streamItemChanges",
+		Summary:     "Acompanha alterações de itens via Server-Sent Events",
+		Tags:        tags,
+		Parameters: []Parameter{
+			queryParam("ids", "IDs de itens separados por vírgula", &Schema{Type: "string"}),
+			queryParam("status", "Filtra pelo status do item", b.schemas.ref(domain.ItemStatus(""))),
+			{Name: "Last-Event-ID", In: "header", Description: "Retoma o stream após o evento informado", Schema: &Schema{Type: "integer", Format: "int64"}},
+		},
+		Responses: responses(
+			http.StatusOK, &Response{
+				Description: "Stream de eventos; cada evento carrega um ItemChange em data",
+				Content:     map[string]MediaType{"text/event-stream": {Schema: b.schemas.ref(domain.ItemChange{})}},
+			},
+			http.StatusBadRequest, b.errorResponse("Filtro inválido"),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+		),
+	})
+
+	b.add(http.MethodGet, "/v1/items/{id}", &Operation{
+		OperationID: "getItem",
+		Summary:     "Obtém um item pelo ID",
+		Tags:        tags,
+		Parameters:  append([]Parameter{idParam("id", "ID do item")}, conditionalParams(true)...),
+		Responses: responses(
+			http.StatusOK, b.cacheableResponse("Item encontrado", b.envelope(item), true),
+			http.StatusNotModified, notModifiedResponse(true),
+			http.StatusBadRequest, b.errorResponse("ID inválido"),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+			http.StatusNotFound, b.errorResponse("Item não encontrado"),
+		),
+	})
+
+	b.add(http.MethodPut, "/v1/items/{id}", &Operation{
+		OperationID: "updateItem",
+		Summary:     "Atualiza um item",
+		Tags:        tags,
+		Parameters:  []Parameter{idParam("id", "ID do item")},
+		RequestBody: b.jsonBody(handlers.ItemRequest{}, true),
+		Responses: responses(
+			http.StatusOK, b.jsonResponse("Item atualizado", b.envelope(item)),
+			http.StatusBadRequest, b.errorResponse("Requisição inválida"),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+			http.StatusNotFound, b.errorResponse("Item não encontrado"),
+			http.StatusConflict, b.errorResponse("Código já utilizado"),
+		),
+	})
+
+	b.add(http.MethodDelete, "/v1/items/{id}", &Operation{
+		OperationID: "deleteItem",
+		Summary:     "Exclui um item",
+		Tags:        tags,
+		Parameters:  []Parameter{idParam("id", "ID do item")},
+		Responses: responses(
+			http.StatusOK, b.jsonResponse("Item excluído", b.envelope(nil)),
+			http.StatusBadRequest, b.errorResponse("ID inválido"),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+			http.StatusNotFound, b.errorResponse("Item não encontrado"),
+		),
+	})
+
+	b.add(http.MethodGet, "/v1/items/by-code/{code}", &Operation{
+		OperationID: "getItemByCode",
+		Summary:     "Obtém um item pelo código",
+		Tags:        tags,
+		Parameters:  append([]Parameter{codeParam()}, conditionalParams(true)...),
+		Responses: responses(
+			http.StatusOK, b.cacheableResponse("Item encontrado", b.envelope(item), true),
+			http.StatusNotModified, notModifiedResponse(true),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+			http.StatusNotFound, b.errorResponse("Item não encontrado"),
+		),
+	})
+
+	b.add(http.MethodPut, "/v1/items/by-code/{code}", &Operation{
+		OperationID: "upsertItemByCode",
+		Summary:     "Cria ou atualiza um item pelo código",
+		Tags:        tags,
+		Parameters:  []Parameter{codeParam()},
+		RequestBody: b.jsonBody(handlers.ItemUpsertRequest{}, true),
+		Responses: responses(
+			http.StatusOK, b.jsonResponse("Item atualizado", b.envelope(item)),
+			http.StatusCreated, b.jsonResponse("Item criado", b.envelope(item)),
+			http.StatusBadRequest, b.errorResponse("Requisição inválida"),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+		),
+	})
+
+	b.add(http.MethodDelete, "/v1/items/by-code/{code}", &Operation{
+		OperationID: "deleteItemByCode",
+		Summary:     "Exclui um item pelo código",
+		Tags:        tags,
+		Parameters:  []Parameter{codeParam()},
+		Responses: responses(
+			http.StatusOK, b.jsonResponse("Item excluído", b.envelope(nil)),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+			http.StatusNotFound, b.errorResponse("Item não encontrado"),
+		),
+	})
+}
+
+func (b *builder) priceOperations() {
+	schedule := b.schemas.ref(domain.ScheduledPrice{})
+	itemID := idParam("id", "ID do item")
+	tags := []string{"Preços"}
+
+	b.add(http.MethodGet, "/v1/items/{id}/price-history", &Operation{
+		OperationID: "listPriceHistory",
+		Summary:     "Lista o histórico de preços de um item",
+		Tags:        tags,
+		Parameters: []Parameter{
+			itemID,
+			queryParam("limit", "Quantidade máxima de registros", &Schema{Type: "integer", Format: "int32"}),
+		},
+		Responses: responses(
+			http.StatusOK, b.jsonResponse("Histórico de preços, do mais recente ao mais antigo", b.envelope(&Schema{Type: "array", Items: b.schemas.ref(domain.PriceHistoryEntry{})})),
+			http.StatusBadRequest, b.errorResponse("ID inválido"),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+			http.StatusNotFound, b.errorResponse("Item não encontrado"),
+		),
+	})
+
+	b.add(http.MethodGet, "/v1/items/{id}/scheduled-prices", &Operation{
+		OperationID: "listScheduledPrices",
+		Summary:     "Lista os agendamentos de preço de um item",
+		Tags:        tags,
+		Parameters:  []Parameter{itemID},
+		Responses: responses(
+			http.StatusOK, b.jsonResponse("Agendamentos de preço", b.envelope(&Schema{Type: "array", Items: schedule})),
+			http.StatusBadRequest, b.errorResponse("ID inválido"),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+			http.StatusNotFound, b.errorResponse("Item não encontrado"),
+		),
+	})
+
+	b.add(http.MethodPost, "/v1/items/{id}/scheduled-prices", &Operation{
+		OperationID: "schedulePrice",
+		Summary:     "Agenda um novo preço para o item",
+		Tags:        tags,
+		Parameters:  []Parameter{itemID},
+		RequestBody: b.jsonBody(handlers.ScheduledPriceRequest{}, true),
+		Responses: responses(
+			http.StatusCreated, b.jsonResponse("Preço agendado", b.envelope(schedule)),
+			http.StatusBadRequest, b.errorResponse("Dados inválidos"),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+			http.StatusNotFound, b.errorResponse("Item não encontrado"),
+			http.StatusConflict, b.errorResponse("Já existe um agendamento no período"),
+		),
+	})
+
+	b.add(http.MethodDelete, "/v1/items/{id}/scheduled-prices/{scheduleId}", &Operation{
+		OperationID: "cancelScheduledPrice",
+		Summary:     "Cancela um agendamento de preço",
+		Tags:        tags,
+		Parameters:  []Parameter{itemID, idParam("scheduleId", "ID do agendamento")},
+		Responses: responses(
+			http.StatusOK, b.jsonResponse("Agendamento cancelado", b.envelope(schedule)),
+			http.StatusBadRequest, b.errorResponse("ID inválido ou agendamento já encerrado"),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+			http.StatusNotFound, b.errorResponse("Agendamento não encontrado"),
+		),
+	})
+}
+
+func (b *builder) promotionOperations() {
+	promotion := b.schemas.ref(domain.Promotion{})
+	tags := []string{"Promoções"}
+
+	b.add(http.MethodPost, "/v1/promotions", &Operation{
+		OperationID: "createPromotion",
+		Summary:     "Cria uma promoção",
+		Tags:        tags,
+		RequestBody: b.jsonBody(handlers.PromotionRequest{}, true),
+		Responses: responses(
+			http.StatusCreated, b.jsonResponse("Promoção criada", b.envelope(promotion)),
+			http.StatusBadRequest, b.errorResponse("Dados inválidos"),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+		),
+	})
+
+	b.add(http.MethodGet, "/v1/promotions", &Operation{
+		OperationID: "listPromotions",
+		Summary:     "Lista as promoções do vendedor",
+		Tags:        tags,
+		Responses: responses(
+			http.StatusOK, b.jsonResponse("Promoções, da maior para a menor prioridade", b.envelope(&Schema{Type: "array", Items: promotion})),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+		),
+	})
+
+	b.add(http.MethodGet, "/v1/promotions/{id}", &Operation{
+		OperationID: "getPromotion",
+		Summary:     "Obtém uma promoção pelo ID",
+		Tags:        tags,
+		Parameters:  []Parameter{idParam("id", "ID da promoção")},
+		Responses: responses(
+			http.StatusOK, b.jsonResponse("Promoção encontrada", b.envelope(promotion)),
+			http.StatusBadRequest, b.errorResponse("ID inválido"),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+			http.StatusNotFound, b.errorResponse("Promoção não encontrada"),
+		),
+	})
+
+	b.add(http.MethodDelete, "/v1/promotions/{id}", &Operation{
+		OperationID: "deactivatePromotion",
+		Summary:     "Desativa uma promoção",
+		Tags:        tags,
+		Parameters:  []Parameter{idParam("id", "ID da promoção")},
+		Responses: responses(
+			http.StatusOK, b.jsonResponse("Promoção desativada", b.envelope(promotion)),
+			http.StatusBadRequest, b.errorResponse("ID inválido ou promoção já desativada"),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+			http.StatusNotFound, b.errorResponse("Promoção não encontrada"),
+		),
+	})
+}
+
+func (b *builder) apiKeyOperations() {
+	created := b.envelope(b.schemas.ref(domain.CreatedAPIKey{}))
+	tags := []string{"Chaves de API"}
+
+	b.add(http.MethodPost, "/v1/api-keys", &Operation{
+		OperationID: "createAPIKey",
+		Summary:     "Cria uma chave de API",
+		Tags:        tags,
+		RequestBody: b.jsonBody(handlers.APIKeyRequest{}, true),
+		Responses: responses(
+			http.StatusCreated, b.jsonResponse("Chave criada; o segredo só é exibido nesta resposta", created),
+			http.StatusBadRequest, b.errorResponse("Requisição inválida"),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+		),
+	})
+
+	b.add(http.MethodGet, "/v1/api-keys", &Operation{
+		OperationID: "listAPIKeys",
+		Summary:     "Lista as chaves de API do vendedor",
+		Tags:        tags,
+		Responses: responses(
+			http.StatusOK, b.jsonResponse("Chaves de API", b.envelope(&Schema{Type: "array", Items: b.schemas.ref(domain.APIKey{})})),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+		),
+	})
+
+	b.add(http.MethodDelete, "/v1/api-keys/{id}", &Operation{
+		OperationID: "revokeAPIKey",
+		Summary:     "Revoga uma chave de API",
+		Tags:        tags,
+		Parameters:  []Parameter{idParam("id", "ID da chave de API")},
+		Responses: responses(
+			http.StatusOK, b.jsonResponse("Chave revogada", b.envelope(nil)),
+			http.StatusBadRequest, b.errorResponse("ID inválido"),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+			http.StatusNotFound, b.errorResponse("Chave não encontrada"),
+		),
+	})
+
+	b.add(http.MethodPost, "/v1/api-keys/{id}/rotate", &Operation{
+		OperationID: "rotateAPIKey",
+		Summary:     "Rotaciona uma chave de API",
+		Tags:        tags,
+		Parameters:  []Parameter{idParam("id", "ID da chave de API")},
+		RequestBody: b.jsonBody(handlers.RotateAPIKeyRequest{}, false),
+		Responses: responses(
+			http.StatusCreated, b.jsonResponse("Nova chave criada", created),
+			http.StatusBadRequest, b.errorResponse("Requisição inválida"),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+			http.StatusNotFound, b.errorResponse("Chave não encontrada"),
+			http.StatusConflict, b.errorResponse("Chave já rotacionada"),
+		),
+	})
+}
+
+func (b *builder) webhookOperations() {
+	subscription := b.schemas.ref(domain.WebhookSubscription{})
+	webhookID := idParam("id", "ID do webhook")
+	tags := []string{"Webhooks"}
+
+	b.add(http.MethodPost, "/v1/webhooks", &Operation{
+		OperationID: "createWebhook",
+		Summary:     "Cria uma assinatura de webhook",
+		Tags:        tags,
+		RequestBody: b.jsonBody(handlers.WebhookRequest{}, true),
+		Responses: responses(
+			http.StatusCreated, b.jsonResponse("Webhook criado; o segredo só é exibido nesta resposta", b.envelope(b.schemas.ref(WebhookCreated{}))),
+			http.StatusBadRequest, b.errorResponse("Requisição inválida"),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+		),
+	})
+
+	b.add(http.MethodGet, "/v1/webhooks", &Operation{
+		OperationID: "listWebhooks",
+		Summary:     "Lista as assinaturas de webhook",
+		Tags:        tags,
+		Responses: responses(
+			http.StatusOK, b.jsonResponse("Assinaturas", b.envelope(&Schema{Type: "array", Items: subscription})),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+		),
+	})
+
+	b.add(http.MethodDelete, "/v1/webhooks/{id}", &Operation{
+		OperationID: "deleteWebhook",
+		Summary:     "Exclui uma assinatura de webhook",
+		Tags:        tags,
+		Parameters:  []Parameter{webhookID},
+		Responses: responses(
+			http.StatusOK, b.jsonResponse("Webhook excluído", b.envelope(nil)),
+			http.StatusBadRequest, b.errorResponse("ID inválido"),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+			http.StatusNotFound, b.errorResponse("Webhook não encontrado"),
+		),
+	})
+
+	b.add(http.MethodPost, "/v1/webhooks/{id}/enable", &Operation{
+		OperationID: "enableWebhook",
+		Summary:     "Reativa uma assinatura desativada",
+		Tags:        tags,
+		Parameters:  []Parameter{webhookID},
+		Responses: responses(
+			http.StatusOK, b.jsonResponse("Webhook reativado", b.envelope(subscription)),
+			http.StatusBadRequest, b.errorResponse("ID inválido"),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+			http.StatusNotFound, b.errorResponse("Webhook não encontrado"),
+		),
+	})
+
+	b.add(http.MethodGet, "/v1/webhooks/{id}/deliveries", &Operation{
+		OperationID: "listWebhookDeliveries",
+		Summary:     "Lista as entregas de um webhook",
+		Tags:        tags,
+		Parameters: []Parameter{
+			webhookID,
+			queryParam("limit", "Quantidade máxima de entregas", &Schema{Type: "integer", Format: "int32"}),
+		},
+		Responses: responses(
+			http.StatusOK, b.jsonResponse("Entregas", b.envelope(&Schema{Type: "array", Items: b.schemas.ref(domain.WebhookDelivery{})})),
+			http.StatusBadRequest, b.errorResponse("ID inválido"),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+			http.StatusNotFound, b.errorResponse("Webhook não encontrado"),
+		),
+	})
+
+	b.add(http.MethodPost, "/v1/webhooks/{id}/deliveries/{deliveryId}/redeliver", &Operation{
+		OperationID: "redeliverWebhook",
+		Summary:     "Agenda o reenvio de uma entrega",
+		Tags:        tags,
+		Parameters:  []Parameter{webhookID, idParam("deliveryId", "ID da entrega")},
+		Responses: responses(
+			http.StatusAccepted, b.jsonResponse("Reenvio agendado", b.envelope(b.schemas.ref(domain.WebhookDelivery{}))),
+			http.StatusBadRequest, b.errorResponse("ID inválido"),
+			http.StatusForbidden, b.errorResponse("Operação não permitida"),
+			http.StatusNotFound, b.errorResponse("Entrega não encontrada"),
+		),
+	})
+}
+
+func (b *builder) graphQLOperations() {
+	result := &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"data":   {Type: "object", Nullable: true},
+			"errors": {Type: "array", Items: &Schema{Type: "object"}},
+		},
+	}
+
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		operation := &Operation{
+			OperationID: strings.ToLower(method) + "GraphQL",
+			Summary:     "Executa uma operação GraphQL",
+			Tags:        []string{"GraphQL"},
+			Responses: responses(
+				http.StatusOK, b.jsonResponse("Resultado GraphQL; erros trazem o código em extensions", result),
+				http.StatusBadRequest, b.errorResponse("Requisição inválida"),
+			),
+		}
+
+		if method == http.MethodGet {
+			operation.Parameters = []Parameter{
+				{Name: "query", In: "query", Required: true, Schema: &Schema{Type: "string"}},
+				queryParam("operationName", "", &Schema{Type: "string"}),
+				queryParam("variables", "Variáveis em JSON", &Schema{Type: "string"}),
+			}
+			operation.Responses[strconv.Itoa(http.StatusMethodNotAllowed)] = b.errorResponse("Mutações exigem POST")
+		} else {
+			operation.RequestBody = b.jsonBody(handlers.GraphQLRequest{}, true)
+		}
+
+		b.add(method, "/graphql", operation)
+	}
+}
+
+func (b *builder) healthOperations() {
+	public := &[]SecurityRequirement{}
+	tags := []string{"Saúde"}
+
+	b.add(http.MethodGet, "/healthz", &Operation{
+		OperationID: "liveness",
+		Summary:     "Verifica se o processo está vivo",
+		Tags:        tags,
+		Security:    public,
+		Responses: responses(
+			http.StatusOK, b.jsonResponse("Processo ativo", &Schema{Type: "object", Properties: map[string]*Schema{"status": {Type: "string"}}}),
+		),
+	})
+
+	b.add(http.MethodGet, "/readyz", &Operation{
+		OperationID: "readiness",
+		Summary:     "Verifica se o serviço está pronto para receber tráfego",
+		Tags:        tags,
+		Security:    public,
+		Responses: responses(
+			http.StatusOK, b.jsonResponse("Serviço pronto", b.schemas.ref(handlers.ReadinessResponse{})),
+			http.StatusServiceUnavailable, b.jsonResponse("Serviço indisponível", b.schemas.ref(handlers.ReadinessResponse{})),
+		),
+	})
+}
@@ -0,0 +1,225 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	apiErrors "github.com/fesbarbosa/melivendas-api/pkg/errors"
+)
+
+const schemaRefPrefix = "#/components/schemas/"
+
+func (d *Document) resolve(schema *Schema) *Schema {
+	for schema != nil && schema.Ref != "" {
+		schema = d.Components.Schemas[strings.TrimPrefix(schema.Ref, schemaRefPrefix)]
+	}
+	return schema
+}
+
+func (d *Document) ValidateJSON(body []byte, schema *Schema) []apiErrors.FieldError {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return []apiErrors.FieldError{apiErrors.NewFieldError("body", "JSON_INVALIDO")}
+	}
+
+	var violations []apiErrors.FieldError
+	d.validateValue("", value, schema, &violations)
+	return violations
+}
+
+func (d *Document) ValidateParameter(parameter Parameter, raw string, present bool) []apiErrors.FieldError {
+	if !present {
+		if parameter.Required {
+			return []apiErrors.FieldError{apiErrors.NewFieldError(parameter.Name, "PARAMETRO_OBRIGATORIO")}
+		}
+		return nil
+	}
+
+	schema := d.resolve(parameter.Schema)
+	if schema == nil {
+		return nil
+	}
+
+	var value interface{} = raw
+	switch schema.Type {
+	case "integer", "number":
+		value = json.Number(raw)
+	case "boolean":
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return []apiErrors.FieldError{apiErrors.NewFieldError(parameter.Name, "DEVE_SER_BOOLEANO")}
+		}
+		value = parsed
+	}
+
+	var violations []apiErrors.FieldError
+	d.validateValue(parameter.Name, value, schema, &violations)
+	return violations
+}
+
+func (d *Document) validateValue(field string, value interface{}, schema *Schema, violations *[]apiErrors.FieldError) {
+	schema = d.resolve(schema)
+	if schema == nil {
+		return
+	}
+
+	fail := func(code string, params ...interface{}) {
+		name := field
+		if name == "" {
+			name = "body"
+		}
+		*violations = append(*violations, apiErrors.NewFieldError(name, code, params...))
+	}
+
+	if value == nil {
+		if !schema.Nullable && (schema.Type != "" || len(schema.AllOf) > 0 || len(schema.OneOf) > 0) {
+			fail("NAO_NULO")
+		}
+		return
+	}
+
+	for _, part := range schema.AllOf {
+		d.validateValue(field, value, part, violations)
+	}
+
+	if len(schema.OneOf) > 0 {
+		matched := false
+		for _, option := range schema.OneOf {
+			var optionViolations []apiErrors.FieldError
+			d.validateValue(field, value, option, &optionViolations)
+			if len(optionViolations) == 0 {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			fail("FORMATO_NAO_ACEITO")
+		}
+	}
+
+	switch schema.Type {
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			fail("DEVE_SER_OBJETO")
+			return
+		}
+		for _, name := range schema.Required {
+			if _, ok := object[name]; !ok {
+				*violations = append(*violations, apiErrors.NewFieldError(joinField(field, name), "CAMPO_OBRIGATORIO"))
+			}
+		}
+		for name, property := range object {
+			if propertySchema, ok := schema.Properties[name]; ok {
+				d.validateValue(joinField(field, name), property, propertySchema, violations)
+			} else if schema.AdditionalProperties != nil {
+				d.validateValue(joinField(field, name), property, schema.AdditionalProperties, violations)
+			}
+		}
+
+	case "array":
+		items, ok := value.([]interface{})
+		if !ok {
+			fail("DEVE_SER_LISTA")
+			return
+		}
+		if schema.MinItems != nil && len(items) < *schema.MinItems {
+			fail("MINIMO_ELEMENTOS", *schema.MinItems)
+		}
+		for i, item := range items {
+			d.validateValue(fmt.Sprintf("%s[%d]", field, i), item, schema.Items, violations)
+		}
+
+	case "string":
+		text, ok := value.(string)
+		if !ok {
+			fail("DEVE_SER_TEXTO")
+			return
+		}
+		if schema.MinLength != nil && utf8.RuneCountInString(text) < *schema.MinLength {
+			if *schema.MinLength == 1 {
+				fail("NAO_VAZIO")
+			} else {
+				fail("MINIMO_CARACTERES", *schema.MinLength)
+			}
+		}
+		if len(schema.Enum) > 0 && !inEnum(text, schema.Enum) {
+			fail("VALOR_FORA_DO_ENUM", enumList(schema.Enum))
+		}
+		switch schema.Format {
+		case "date-time":
+			if _, err := time.Parse(time.RFC3339, text); err != nil {
+				fail("DATA_INVALIDA")
+			}
+		case "uri":
+			parsed, err := url.ParseRequestURI(text)
+			if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+				fail("URL_INVALIDA")
+			}
+		}
+
+	case "integer", "number":
+		number, ok := value.(json.Number)
+		if !ok {
+			fail("DEVE_SER_NUMERICO")
+			return
+		}
+		var numeric float64
+		if schema.Type == "integer" {
+			parsed, err := number.Int64()
+			if err != nil {
+				fail("DEVE_SER_INTEIRO")
+				return
+			}
+			numeric = float64(parsed)
+		} else {
+			parsed, err := number.Float64()
+			if err != nil {
+				fail("DEVE_SER_NUMERICO")
+				return
+			}
+			numeric = parsed
+		}
+		if schema.Minimum != nil && numeric < *schema.Minimum {
+			fail("VALOR_MINIMO", strconv.FormatFloat(*schema.Minimum, 'f', -1, 64))
+		}
+
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			fail("DEVE_SER_BOOLEANO")
+		}
+	}
+}
+
+func joinField(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+func inEnum(value string, enum []interface{}) bool {
+	for _, candidate := range enum {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
+
+func enumList(enum []interface{}) string {
+	values := make([]string, 0, len(enum))
+	for _, value := range enum {
+		values = append(values, fmt.Sprint(value))
+	}
+	return strings.Join(values, ", ")
+}
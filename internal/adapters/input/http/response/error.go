@@ -0,0 +1,63 @@
+package response
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/apierror"
+	apiErrors "github.com/fesbarbosa/melivendas-api/pkg/errors"
+	"github.com/fesbarbosa/melivendas-api/pkg/i18n"
+	"github.com/fesbarbosa/melivendas-api/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	ProblemJSONKey         = "problem_json"
+	ProblemJSONContentType = "application/problem+json"
+)
+
+func Error(c *gin.Context, err error) {
+	render(c, apierror.From(err), err)
+}
+
+func BindingError(c *gin.Context, err error) {
+	render(c, apiErrors.NewBindingError(err), nil)
+}
+
+func ValidationError(c *gin.Context, fields []apiErrors.FieldError) {
+	render(c, apiErrors.NewValidationError(fields), nil)
+}
+
+func APIError(c *gin.Context, apiErr *apiErrors.APIError) {
+	render(c, apiErr, nil)
+}
+
+func render(c *gin.Context, apiErr *apiErrors.APIError, cause error) {
+	ctx := c.Request.Context()
+	apiErr.RequestID, _ = logger.RequestIDFromContext(ctx)
+	apiErr.Localize(i18n.LanguageFromContext(ctx))
+
+	if apiErr.Status >= http.StatusInternalServerError && cause != nil {
+		slog.ErrorContext(ctx, "erro interno ao processar requisição",
+			"method", c.Request.Method,
+			"route", c.FullPath(),
+			"error", cause,
+		)
+	}
+
+	if wantsProblem(c) {
+		c.Header("Content-Type", ProblemJSONContentType)
+		c.AbortWithStatusJSON(apiErr.Status, apiErr.Problem(c.Request.URL.Path))
+		return
+	}
+
+	c.AbortWithStatusJSON(apiErr.Status, apiErr)
+}
+
+func wantsProblem(c *gin.Context) bool {
+	if c.GetBool(ProblemJSONKey) {
+		return true
+	}
+	return strings.Contains(c.GetHeader("Accept"), ProblemJSONContentType)
+}
@@ -0,0 +1,10 @@
+package response
+
+import (
+	"github.com/fesbarbosa/melivendas-api/pkg/i18n"
+	"github.com/gin-gonic/gin"
+)
+
+func Message(c *gin.Context, key string, args ...interface{}) string {
+	return i18n.Translate(i18n.LanguageFromContext(c.Request.Context()), key, args...)
+}
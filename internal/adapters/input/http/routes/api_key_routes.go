@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterAPIKeyRoutes(router *gin.Engine, apiKeyHandler *handlers.APIKeyHandler) {
+	v1 := router.Group("/v1")
+	{
+		apiKeys := v1.Group("/api-keys")
+		{
+			apiKeys.POST("", apiKeyHandler.Create)
+			apiKeys.GET("", apiKeyHandler.List)
+			apiKeys.DELETE("/:id", apiKeyHandler.Revoke)
+			apiKeys.POST("/:id/rotate", apiKeyHandler.Rotate)
+		}
+	}
+}
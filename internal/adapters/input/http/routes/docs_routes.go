@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/openapi"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterDocsRoutes(router *gin.Engine, document *openapi.Document) {
+	router.GET("/openapi.json", func(c *gin.Context) {
+		c.JSON(http.StatusOK, document)
+	})
+	router.GET("/docs", func(c *gin.Context) {
+		c.Data(http.StatusOK, "text/html; charset=utf-8", openapi.DocsPage)
+	})
+}
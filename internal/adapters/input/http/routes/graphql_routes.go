@@ -0,0 +1,11 @@
+package routes
+
+import (
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterGraphQLRoutes(router *gin.Engine, graphQLHandler *handlers.GraphQLHandler) {
+	router.POST("/graphql", graphQLHandler.Query)
+	router.GET("/graphql", graphQLHandler.Query)
+}
@@ -0,0 +1,11 @@
+package routes
+
+import (
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterHealthRoutes(router *gin.Engine, healthHandler *handlers.HealthHandler) {
+	router.GET("/healthz", healthHandler.Liveness)
+	router.GET("/readyz", healthHandler.Readiness)
+}
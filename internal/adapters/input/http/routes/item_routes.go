@@ -5,16 +5,20 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func RegisterItemRoutes(router *gin.Engine, itemHandler *handlers.ItemHandler) {
+func RegisterItemRoutes(router *gin.Engine, itemHandler *handlers.ItemHandler, itemStreamHandler *handlers.ItemStreamHandler) {
 	v1 := router.Group("/v1")
 	{
 		items := v1.Group("/items")
 		{
 			items.POST("", itemHandler.Create)
 			items.GET("", itemHandler.List)
+			items.GET("/stream", itemStreamHandler.Stream)
 			items.GET("/:id", itemHandler.GetByID)
 			items.PUT("/:id", itemHandler.Update)
 			items.DELETE("/:id", itemHandler.Delete)
+			items.GET("/by-code/:code", itemHandler.GetByCode)
+			items.PUT("/by-code/:code", itemHandler.UpsertByCode)
+			items.DELETE("/by-code/:code", itemHandler.DeleteByCode)
 		}
 	}
 }
@@ -0,0 +1,11 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterMetricsRoutes(router *gin.Engine, metricsHandler http.Handler) {
+	router.GET("/metrics", gin.WrapH(metricsHandler))
+}
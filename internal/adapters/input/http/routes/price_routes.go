@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterPriceRoutes(router *gin.Engine, priceHandler *handlers.PriceHandler) {
+	v1 := router.Group("/v1")
+	{
+		items := v1.Group("/items")
+		{
+			items.GET("/:id/price-history", priceHandler.ListHistory)
+			items.GET("/:id/scheduled-prices", priceHandler.ListSchedules)
+			items.POST("/:id/scheduled-prices", priceHandler.Schedule)
+			items.DELETE("/:id/scheduled-prices/:scheduleId", priceHandler.CancelSchedule)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterPromotionRoutes(router *gin.Engine, promotionHandler *handlers.PromotionHandler) {
+	v1 := router.Group("/v1")
+	{
+		promotions := v1.Group("/promotions")
+		{
+			promotions.POST("", promotionHandler.Create)
+			promotions.GET("", promotionHandler.List)
+			promotions.GET("/:id", promotionHandler.GetByID)
+			promotions.DELETE("/:id", promotionHandler.Deactivate)
+		}
+	}
+}
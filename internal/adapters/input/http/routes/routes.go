@@ -0,0 +1,34 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/handlers"
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/openapi"
+	"github.com/gin-gonic/gin"
+)
+
+type Handlers struct {
+	Health     *handlers.HealthHandler
+	Item       *handlers.ItemHandler
+	ItemStream *handlers.ItemStreamHandler
+	Price      *handlers.PriceHandler
+	Promotion  *handlers.PromotionHandler
+	APIKey     *handlers.APIKeyHandler
+	Webhook    *handlers.WebhookHandler
+	GraphQL    *handlers.GraphQLHandler
+	Metrics    http.Handler
+	OpenAPI    *openapi.Document
+}
+
+func Register(router *gin.Engine, h Handlers) {
+	RegisterHealthRoutes(router, h.Health)
+	RegisterItemRoutes(router, h.Item, h.ItemStream)
+	RegisterPriceRoutes(router, h.Price)
+	RegisterPromotionRoutes(router, h.Promotion)
+	RegisterAPIKeyRoutes(router, h.APIKey)
+	RegisterWebhookRoutes(router, h.Webhook)
+	RegisterGraphQLRoutes(router, h.GraphQL)
+	RegisterMetricsRoutes(router, h.Metrics)
+	RegisterDocsRoutes(router, h.OpenAPI)
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/input/http/handlers"
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterWebhookRoutes(router *gin.Engine, webhookHandler *handlers.WebhookHandler) {
+	v1 := router.Group("/v1")
+	{
+		webhooks := v1.Group("/webhooks")
+		{
+			webhooks.POST("", webhookHandler.Create)
+			webhooks.GET("", webhookHandler.List)
+			webhooks.DELETE("/:id", webhookHandler.Delete)
+			webhooks.POST("/:id/enable", webhookHandler.Enable)
+			webhooks.GET("/:id/deliveries", webhookHandler.ListDeliveries)
+			webhooks.POST("/:id/deliveries/:deliveryId/redeliver", webhookHandler.Redeliver)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package authz
+
+import (
+	"context"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+)
+
+var defaultRolePermissions = map[string][]domain.Action{
+	domain.RoleViewer: {
+		domain.ActionItemRead,
+	},
+	domain.RoleEditor: {
+		domain.ActionItemRead,
+		domain.ActionItemCreate,
+		domain.ActionItemUpdate,
+		domain.ActionPromotionManage,
+	},
+	domain.RoleInventoryOperator: {
+		domain.ActionItemRead,
+		domain.ActionItemChangeStock,
+	},
+	domain.RoleAdmin: {
+		domain.ActionItemRead,
+		domain.ActionItemCreate,
+		domain.ActionItemUpdate,
+		domain.ActionItemChangeStock,
+		domain.ActionItemDelete,
+		domain.ActionAPIKeyManage,
+		domain.ActionWebhookManage,
+		domain.ActionPromotionManage,
+	},
+}
+
+type RolePolicy struct {
+	permissions map[string]map[domain.Action]struct{}
+}
+
+func NewRolePolicy() *RolePolicy {
+	permissions := make(map[string]map[domain.Action]struct{}, len(defaultRolePermissions))
+	for role, actions := range defaultRolePermissions {
+		permissions[role] = make(map[domain.Action]struct{}, len(actions))
+		for _, action := range actions {
+			permissions[role][action] = struct{}{}
+		}
+	}
+
+	return &RolePolicy{
+		permissions: permissions,
+	}
+}
+
+func (p *RolePolicy) IsAllowed(ctx context.Context, principal *domain.Principal, action domain.Action) (bool, error) {
+	if principal == nil {
+		return false, nil
+	}
+
+	if principal.HasScope(string(action)) {
+		return true, nil
+	}
+
+	for _, role := range principal.Roles {
+		if _, ok := p.permissions[role][action]; ok {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+type AllowAllPolicy struct{}
+
+func NewAllowAllPolicy() *AllowAllPolicy {
+	return &AllowAllPolicy{}
+}
+
+func (p *AllowAllPolicy) IsAllowed(ctx context.Context, principal *domain.Principal, action domain.Action) (bool, error) {
+	return true, nil
+}
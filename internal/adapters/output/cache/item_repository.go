@@ -0,0 +1,225 @@
+package cache
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	itemCache  = "item"
+	countCache = "item_count"
+
+	keySeparator = "\x00"
+)
+
+type CachedItemRepository struct {
+	next   output.ItemRepository
+	items  *lru
+	counts *lru
+	group  singleflight.Group
+
+	mu         sync.Mutex
+	generation uint64
+
+	requests  *prometheus.CounterVec
+	evictions *prometheus.CounterVec
+}
+
+func NewCachedItemRepository(next output.ItemRepository, size int, ttl time.Duration, registerer prometheus.Registerer) *CachedItemRepository {
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "melivendas",
+		Name:      "cache_requests_total",
+		Help:      "Consultas ao cache de itens por cache e resultado (hit ou miss).",
+	}, []string{"cache", "result"})
+	evictions := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "melivendas",
+		Name:      "cache_evictions_total",
+		Help:      "Entradas removidas do cache de itens por falta de espaço.",
+	}, []string{"cache"})
+	registerer.MustRegister(requests, evictions)
+
+	return &CachedItemRepository{
+		next:      next,
+		items:     newLRU(size, ttl),
+		counts:    newLRU(size, ttl),
+		requests:  requests,
+		evictions: evictions,
+	}
+}
+
+func itemKey(sellerID string, id int64) string {
+	return sellerID + keySeparator + strconv.FormatInt(id, 10)
+}
+
+func countKey(sellerID, status string) string {
+	return sellerID + keySeparator + status
+}
+
+func cloneItem(item *domain.Item) *domain.Item {
+	cloned := *item
+	return &cloned
+}
+
+func (r *CachedItemRepository) lookup(cache string, store *lru, key string) (interface{}, bool) {
+	value, ok := store.get(key)
+	if ok {
+		r.requests.WithLabelValues(cache, "hit").Inc()
+	} else {
+		r.requests.WithLabelValues(cache, "miss").Inc()
+	}
+	return value, ok
+}
+
+func (r *CachedItemRepository) load(ctx context.Context, cache string, store *lru, key string, fetch func(context.Context) (interface{}, error)) (interface{}, error) {
+	result := r.group.DoChan(cache+keySeparator+key, func() (interface{}, error) {
+		r.mu.Lock()
+		generation := r.generation
+		r.mu.Unlock()
+
+		value, err := fetch(context.WithoutCancel(ctx))
+		if err != nil || value == nil {
+			return value, err
+		}
+
+		r.mu.Lock()
+		if r.generation == generation {
+			if evicted := store.set(key, value); evicted > 0 {
+				r.evictions.WithLabelValues(cache).Add(float64(evicted))
+			}
+		}
+		r.mu.Unlock()
+
+		return value, nil
+	})
+
+	select {
+	case res := <-result:
+		return res.Val, res.Err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (r *CachedItemRepository) invalidate(ctx context.Context, ids ...int64) {
+	sellerID, ok := domain.SellerIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	r.evict(sellerID, ids...)
+
+	if output.InTx(ctx) {
+		output.AfterCommit(ctx, func() {
+			r.evict(sellerID, ids...)
+		})
+	}
+}
+
+func (r *CachedItemRepository) evict(sellerID string, ids ...int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.generation++
+
+	for _, id := range ids {
+		key := itemKey(sellerID, id)
+		r.items.remove(key)
+		r.group.Forget(itemCache + keySeparator + key)
+	}
+
+	r.counts.removePrefix(sellerID + keySeparator)
+	for _, status := range []string{"", string(domain.ItemStatusActive), string(domain.ItemStatusInactive)} {
+		r.group.Forget(countCache + keySeparator + countKey(sellerID, status))
+	}
+}
+
+func (r *CachedItemRepository) Create(ctx context.Context, item *domain.Item) (*domain.Item, error) {
+	created, err := r.next.Create(ctx, item)
+	r.invalidate(ctx)
+	return created, err
+}
+
+func (r *CachedItemRepository) GetByID(ctx context.Context, id int64) (*domain.Item, error) {
+	sellerID, ok := domain.SellerIDFromContext(ctx)
+	if !ok || output.InTx(ctx) {
+		return r.next.GetByID(ctx, id)
+	}
+
+	key := itemKey(sellerID, id)
+	if cached, ok := r.lookup(itemCache, r.items, key); ok {
+		return cloneItem(cached.(*domain.Item)), nil
+	}
+
+	value, err := r.load(ctx, itemCache, r.items, key, func(ctx context.Context) (interface{}, error) {
+		item, err := r.next.GetByID(ctx, id)
+		if err != nil || item == nil {
+			return nil, err
+		}
+		return item, nil
+	})
+	if err != nil || value == nil {
+		return nil, err
+	}
+
+	return cloneItem(value.(*domain.Item)), nil
+}
+
+func (r *CachedItemRepository) GetByCode(ctx context.Context, code string) (*domain.Item, error) {
+	return r.next.GetByCode(ctx, code)
+}
+
+func (r *CachedItemRepository) CreateOrLockByCode(ctx context.Context, item *domain.Item) (*domain.Item, bool, error) {
+	current, created, err := r.next.CreateOrLockByCode(ctx, item)
+	if created {
+		r.invalidate(ctx, current.ID)
+	}
+	return current, created, err
+}
+
+func (r *CachedItemRepository) Update(ctx context.Context, item *domain.Item) error {
+	err := r.next.Update(ctx, item)
+	r.invalidate(ctx, item.ID)
+	return err
+}
+
+func (r *CachedItemRepository) Delete(ctx context.Context, item *domain.Item) error {
+	err := r.next.Delete(ctx, item)
+	r.invalidate(ctx, item.ID)
+	return err
+}
+
+func (r *CachedItemRepository) FindAll(ctx context.Context, status string, limit, offset int) ([]*domain.Item, error) {
+	return r.next.FindAll(ctx, status, limit, offset)
+}
+
+func (r *CachedItemRepository) Count(ctx context.Context, status string) (int, error) {
+	sellerID, ok := domain.SellerIDFromContext(ctx)
+	if !ok || output.InTx(ctx) {
+		return r.next.Count(ctx, status)
+	}
+
+	key := countKey(sellerID, status)
+	if cached, ok := r.lookup(countCache, r.counts, key); ok {
+		return cached.(int), nil
+	}
+
+	value, err := r.load(ctx, countCache, r.counts, key, func(ctx context.Context) (interface{}, error) {
+		return r.next.Count(ctx, status)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return value.(int), nil
+}
+
+func (r *CachedItemRepository) ExistsByCode(ctx context.Context, code string, excludeID int64) (bool, error) {
+	return r.next.ExistsByCode(ctx, code, excludeID)
+}
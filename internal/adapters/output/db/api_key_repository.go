@@ -0,0 +1,197 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+type apiKeyRow struct {
+	ID          int64         `db:"id"`
+	SellerID    string        `db:"seller_id"`
+	Name        string        `db:"name"`
+	Prefix      string        `db:"prefix"`
+	SecretHash  string        `db:"secret_hash"`
+	Scopes      string        `db:"scopes"`
+	CreatedBy   string        `db:"created_by"`
+	RotatedFrom sql.NullInt64 `db:"rotated_from"`
+	CreatedAt   time.Time     `db:"created_at"`
+	ExpiresAt   sql.NullTime  `db:"expires_at"`
+	RevokedAt   sql.NullTime  `db:"revoked_at"`
+	LastUsedAt  sql.NullTime  `db:"last_used_at"`
+}
+
+func (r *apiKeyRow) toDomain() *domain.APIKey {
+	key := &domain.APIKey{
+		ID:         r.ID,
+		SellerID:   r.SellerID,
+		Name:       r.Name,
+		Prefix:     r.Prefix,
+		SecretHash: r.SecretHash,
+		Scopes:     strings.Split(r.Scopes, ","),
+		CreatedBy:  r.CreatedBy,
+		CreatedAt:  r.CreatedAt,
+	}
+
+	if r.RotatedFrom.Valid {
+		key.RotatedFrom = &r.RotatedFrom.Int64
+	}
+	if r.ExpiresAt.Valid {
+		key.ExpiresAt = &r.ExpiresAt.Time
+	}
+	if r.RevokedAt.Valid {
+		key.RevokedAt = &r.RevokedAt.Time
+	}
+	if r.LastUsedAt.Valid {
+		key.LastUsedAt = &r.LastUsedAt.Time
+	}
+
+	return key
+}
+
+type APIKeyRepository struct {
+	db *sqlx.DB
+}
+
+func NewAPIKeyRepository(db *sqlx.DB) *APIKeyRepository {
+	return &APIKeyRepository{
+		db: db,
+	}
+}
+
+func (r *APIKeyRepository) Create(ctx context.Context, key *domain.APIKey) (*domain.APIKey, error) {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		INSERT INTO api_keys (seller_id, name, prefix, secret_hash, scopes, created_by, rotated_from, created_at, expires_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := execer(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		sellerID,
+		key.Name,
+		key.Prefix,
+		key.SecretHash,
+		strings.Join(key.Scopes, ","),
+		key.CreatedBy,
+		key.RotatedFrom,
+		key.CreatedAt,
+		key.ExpiresAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	key.ID = id
+	key.SellerID = sellerID
+	return key, nil
+}
+
+func (r *APIKeyRepository) GetByID(ctx context.Context, id int64) (*domain.APIKey, error) {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.get(ctx, forUpdate(ctx, "SELECT * FROM api_keys WHERE id = ? AND seller_id = ?"), id, sellerID)
+}
+
+func (r *APIKeyRepository) GetByPrefix(ctx context.Context, prefix string) (*domain.APIKey, error) {
+	return r.get(ctx, "SELECT * FROM api_keys WHERE prefix = ?", prefix)
+}
+
+func (r *APIKeyRepository) get(ctx context.Context, query string, args ...interface{}) (*domain.APIKey, error) {
+	var row apiKeyRow
+	err := sqlx.GetContext(ctx, queryer(ctx, r.db), &row, query, args...)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return row.toDomain(), nil
+}
+
+func (r *APIKeyRepository) FindAll(ctx context.Context) ([]*domain.APIKey, error) {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "SELECT * FROM api_keys WHERE seller_id = ? ORDER BY created_at DESC"
+
+	rows := []apiKeyRow{}
+	err = r.db.SelectContext(ctx, &rows, query, sellerID)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*domain.APIKey, 0, len(rows))
+	for i := range rows {
+		keys = append(keys, rows[i].toDomain())
+	}
+
+	return keys, nil
+}
+
+func (r *APIKeyRepository) Revoke(ctx context.Context, id int64, revokedAt time.Time) error {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := "UPDATE api_keys SET revoked_at = ? WHERE id = ? AND seller_id = ? AND revoked_at IS NULL"
+
+	_, err = execer(ctx, r.db).ExecContext(ctx, query, revokedAt, id, sellerID)
+	return err
+}
+
+func (r *APIKeyRepository) SetExpiration(ctx context.Context, id int64, expiresAt time.Time) error {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := "UPDATE api_keys SET expires_at = ? WHERE id = ? AND seller_id = ?"
+
+	_, err = execer(ctx, r.db).ExecContext(ctx, query, expiresAt, id, sellerID)
+	return err
+}
+
+func (r *APIKeyRepository) HasSuccessor(ctx context.Context, id int64) (bool, error) {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	query := "SELECT COUNT(*) FROM api_keys WHERE rotated_from = ? AND seller_id = ?"
+
+	var count int
+	err = sqlx.GetContext(ctx, queryer(ctx, r.db), &count, query, id, sellerID)
+	return count > 0, err
+}
+
+func (r *APIKeyRepository) UpdateLastUsed(ctx context.Context, id int64, usedAt time.Time) error {
+	query := "UPDATE api_keys SET last_used_at = ? WHERE id = ?"
+
+	_, err := r.db.ExecContext(ctx, query, usedAt, id)
+	return err
+}
@@ -3,21 +3,24 @@ package db
 import (
 	"fmt"
 	"io/ioutil"
-	"log"
+	"log/slog"
 	"strings"
 
+	"github.com/XSAM/otelsql"
 	"github.com/fesbarbosa/melivendas-api/internal/config"
 	"github.com/go-sql-driver/mysql"
 	"github.com/jmoiron/sqlx"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
 )
 
 func InitDB(cfg *config.DatabaseConfig) (*sqlx.DB, error) {
 
 	dsn := cfg.GetDSN()
-	db, err := sqlx.Open(cfg.Driver, dsn)
+	sqlDB, err := otelsql.Open(cfg.Driver, dsn, otelsql.WithAttributes(semconv.DBSystemMySQL))
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database connection: %w", err)
 	}
+	db := sqlx.NewDb(sqlDB, cfg.Driver)
 
 	if err := db.Ping(); err != nil {
 
@@ -51,6 +54,10 @@ func InitDB(cfg *config.DatabaseConfig) (*sqlx.DB, error) {
 		return nil, fmt.Errorf("failed to run database migrations: %w", err)
 	}
 
+	if err := runSchemaUpgrades(db, cfg.LegacySellerID); err != nil {
+		return nil, fmt.Errorf("failed to upgrade database schema: %w", err)
+	}
+
 	return db, nil
 }
 
@@ -75,6 +82,6 @@ func runMigrations(db *sqlx.DB) error {
 		}
 	}
 
-	log.Println("Database migrations completed successfully")
+	slog.Info("Database migrations completed successfully")
 	return nil
 }
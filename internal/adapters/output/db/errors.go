@@ -0,0 +1,17 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+const (
+	mysqlErrDuplicateEntry = 1062
+	mysqlErrDeadlock       = 1213
+)
+
+func isMySQLError(err error, number uint16) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == number
+}
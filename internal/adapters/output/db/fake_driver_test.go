@@ -0,0 +1,164 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var fakeDriverSeq atomic.Int64
+
+type fakeResponse struct {
+	columns      []string
+	rows         [][]driver.Value
+	lastInsertID int64
+	rowsAffected int64
+}
+
+type fakeHandler func(query string, args []driver.NamedValue) (*fakeResponse, error)
+
+type fakeDB struct {
+	handler fakeHandler
+
+	mu        sync.Mutex
+	queries   []string
+	begins    int
+	commits   int
+	rollbacks int
+}
+
+func newFakeDB(t *testing.T, handler fakeHandler) (*sqlx.DB, *fakeDB) {
+	t.Helper()
+
+	fake := &fakeDB{handler: handler}
+	name := fmt.Sprintf("fake-mysql-%d", fakeDriverSeq.Add(1))
+	sql.Register(name, fake)
+
+	sqlDB, err := sql.Open(name, "")
+	if err != nil {
+		t.Fatalf("abrir banco falso: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	return sqlx.NewDb(sqlDB, "mysql"), fake
+}
+
+func (d *fakeDB) Open(string) (driver.Conn, error) {
+	return &fakeConn{db: d}, nil
+}
+
+func (d *fakeDB) run(query string, args []driver.NamedValue) (*fakeResponse, error) {
+	d.mu.Lock()
+	d.queries = append(d.queries, query)
+	d.mu.Unlock()
+
+	response, err := d.handler(query, args)
+	if err != nil {
+		return nil, err
+	}
+	if response == nil {
+		response = &fakeResponse{}
+	}
+	return response, nil
+}
+
+func (d *fakeDB) counts() (begins, commits, rollbacks int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.begins, d.commits, d.rollbacks
+}
+
+type fakeConn struct {
+	db *fakeDB
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepare não suportado")
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	c.db.mu.Lock()
+	c.db.begins++
+	c.db.mu.Unlock()
+	return &fakeTx{db: c.db}, nil
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	response, err := c.db.run(query, args)
+	if err != nil {
+		return nil, err
+	}
+	return fakeResult{response}, nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	response, err := c.db.run(query, args)
+	if err != nil {
+		return nil, err
+	}
+	return &fakeRows{response: response}, nil
+}
+
+type fakeTx struct {
+	db *fakeDB
+}
+
+func (t *fakeTx) Commit() error {
+	t.db.mu.Lock()
+	t.db.commits++
+	t.db.mu.Unlock()
+	return nil
+}
+
+func (t *fakeTx) Rollback() error {
+	t.db.mu.Lock()
+	t.db.rollbacks++
+	t.db.mu.Unlock()
+	return nil
+}
+
+type fakeResult struct {
+	response *fakeResponse
+}
+
+func (r fakeResult) LastInsertId() (int64, error) {
+	return r.response.lastInsertID, nil
+}
+
+func (r fakeResult) RowsAffected() (int64, error) {
+	return r.response.rowsAffected, nil
+}
+
+type fakeRows struct {
+	response *fakeResponse
+	pos      int
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.response.columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.response.rows) {
+		return io.EOF
+	}
+	copy(dest, r.response.rows[r.pos])
+	r.pos++
+	return nil
+}
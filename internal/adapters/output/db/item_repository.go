@@ -4,157 +4,374 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"log/slog"
 
 	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
 	"github.com/jmoiron/sqlx"
 )
 
 type ItemRepository struct {
-	db *sqlx.DB
+	db       *sqlx.DB
+	replicas *ReplicaSet
 }
 
-func NewItemRepository(db *sqlx.DB) *ItemRepository {
+func NewItemRepository(db *sqlx.DB, replicas *ReplicaSet) *ItemRepository {
 	return &ItemRepository{
-		db: db,
+		db:       db,
+		replicas: replicas,
 	}
 }
 
+func (r *ItemRepository) logError(ctx context.Context, method string, err error) error {
+	if err != nil {
+		slog.ErrorContext(ctx, "item repository query failed", "method", method, "error", err)
+	}
+	return err
+}
+
 func (r *ItemRepository) Create(ctx context.Context, item *domain.Item) (*domain.Item, error) {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	query := `
-		INSERT INTO items (code, title, description, price, stock, status, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		INSERT INTO items (seller_id, code, title, description, category, price, stock, status, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
-	result, err := r.db.ExecContext(
-		ctx,
-		query,
-		item.Code,
-		item.Title,
-		item.Description,
-		item.Price,
-		item.Stock,
-		item.Status,
-		item.CreatedAt,
-		item.UpdatedAt,
-	)
+	item.SellerID = sellerID
+	events := item.PullEvents()
 
-	if err != nil {
-		return nil, err
-	}
+	err = withTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		result, err := tx.ExecContext(
+			ctx,
+			query,
+			sellerID,
+			item.Code,
+			item.Title,
+			item.Description,
+			item.Category,
+			item.Price,
+			item.Stock,
+			item.Status,
+			item.CreatedAt,
+			item.UpdatedAt,
+		)
+		if err != nil {
+			return err
+		}
 
-	id, err := result.LastInsertId()
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		item.ID = id
+		assignItemID(events, id)
+
+		if err := insertPriceHistory(ctx, tx, events); err != nil {
+			return err
+		}
+
+		return insertOutboxEvents(ctx, tx, events)
+	})
+
+	if isMySQLError(err, mysqlErrDuplicateEntry) {
+		return nil, domain.ErrDuplicateItemCode
+	}
 	if err != nil {
-		return nil, err
+		return nil, r.logError(ctx, "Create", err)
 	}
 
-	item.ID = id
+	r.replicas.markWrite(ctx)
+
 	return item, nil
 }
 
 func (r *ItemRepository) GetByID(ctx context.Context, id int64) (*domain.Item, error) {
-	query := "SELECT * FROM items WHERE id = ?"
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "SELECT * FROM items WHERE id = ? AND seller_id = ?"
 
 	var item domain.Item
-	err := r.db.GetContext(ctx, &item, query, id)
+	err = r.replicas.read(ctx, func(q sqlx.QueryerContext) error {
+		return sqlx.GetContext(ctx, q, &item, forUpdate(ctx, query), id, sellerID)
+	})
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
 		}
+		return nil, r.logError(ctx, "GetByID", err)
+	}
+
+	return &item, nil
+}
+
+func (r *ItemRepository) GetByCode(ctx context.Context, code string) (*domain.Item, error) {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
 		return nil, err
 	}
 
+	query := "SELECT * FROM items WHERE code = ? AND seller_id = ?"
+
+	var item domain.Item
+	err = sqlx.GetContext(ctx, queryer(ctx, r.db), &item, forUpdate(ctx, query), code, sellerID)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, r.logError(ctx, "GetByCode", err)
+	}
+
 	return &item, nil
 }
 
+func (r *ItemRepository) CreateOrLockByCode(ctx context.Context, item *domain.Item) (*domain.Item, bool, error) {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	query := `
+		INSERT INTO items (seller_id, code, title, description, category, price, stock, status, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE id = LAST_INSERT_ID(id)
+	`
+
+	var current domain.Item
+	var created bool
+
+	item.SellerID = sellerID
+	events := item.PullEvents()
+
+	err = withTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		result, err := tx.ExecContext(
+			ctx,
+			query,
+			sellerID,
+			item.Code,
+			item.Title,
+			item.Description,
+			item.Category,
+			item.Price,
+			item.Stock,
+			item.Status,
+			item.CreatedAt,
+			item.UpdatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		created = affected == 1
+
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		if !created {
+			return tx.GetContext(ctx, &current, "SELECT * FROM items WHERE id = ? AND seller_id = ? FOR UPDATE", id, sellerID)
+		}
+
+		item.ID = id
+		assignItemID(events, id)
+
+		if err := insertPriceHistory(ctx, tx, events); err != nil {
+			return err
+		}
+
+		return insertOutboxEvents(ctx, tx, events)
+	})
+
+	if err != nil {
+		return nil, false, r.logError(ctx, "CreateOrLockByCode", err)
+	}
+
+	if created {
+		r.replicas.markWrite(ctx)
+		return item, true, nil
+	}
+
+	return &current, false, nil
+}
+
 func (r *ItemRepository) Update(ctx context.Context, item *domain.Item) error {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	events := item.PullEvents()
+
+	err = withTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		if err := updateItemRow(ctx, tx, sellerID, item); err != nil {
+			return err
+		}
+
+		if err := insertPriceHistory(ctx, tx, events); err != nil {
+			return err
+		}
+
+		return insertOutboxEvents(ctx, tx, events)
+	})
+	if isMySQLError(err, mysqlErrDuplicateEntry) {
+		return domain.ErrDuplicateItemCode
+	}
+	if err != nil {
+		return r.logError(ctx, "Update", err)
+	}
+
+	r.replicas.markWrite(ctx)
+	return nil
+}
+
+func assignItemID(events []domain.ItemEvent, id int64) {
+	for idx := range events {
+		events[idx].ItemID = id
+		events[idx].Item.ID = id
+	}
+}
+
+func updateItemRow(ctx context.Context, tx *sqlx.Tx, sellerID string, item *domain.Item) error {
 	query := `
 		UPDATE items
-		SET code = ?, title = ?, description = ?, price = ?, stock = ?, status = ?, updated_at = ?
-		WHERE id = ?
+		SET code = ?, title = ?, description = ?, category = ?, price = ?, stock = ?, status = ?, updated_at = ?
+		WHERE id = ? AND seller_id = ?
 	`
 
-	_, err := r.db.ExecContext(
+	_, err := tx.ExecContext(
 		ctx,
 		query,
 		item.Code,
 		item.Title,
 		item.Description,
+		item.Category,
 		item.Price,
 		item.Stock,
 		item.Status,
 		item.UpdatedAt,
 		item.ID,
+		sellerID,
 	)
-
 	return err
 }
 
-func (r *ItemRepository) Delete(ctx context.Context, id int64) error {
-	query := "DELETE FROM items WHERE id = ?"
+func (r *ItemRepository) Delete(ctx context.Context, item *domain.Item) error {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return err
+	}
 
-	_, err := r.db.ExecContext(ctx, query, id)
-	return err
+	query := "DELETE FROM items WHERE id = ? AND seller_id = ?"
+
+	events := item.PullEvents()
+
+	err = withTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		_, err := tx.ExecContext(ctx, query, item.ID, sellerID)
+		if err != nil {
+			return err
+		}
+
+		return insertOutboxEvents(ctx, tx, events)
+	})
+	if err != nil {
+		return r.logError(ctx, "Delete", err)
+	}
+
+	r.replicas.markWrite(ctx)
+	return nil
 }
 
 func (r *ItemRepository) FindAll(ctx context.Context, status string, limit, offset int) ([]*domain.Item, error) {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var query string
 	var args []interface{}
 
 	if status != "" {
-		query = "SELECT * FROM items WHERE status = ? ORDER BY updated_at DESC LIMIT ? OFFSET ?"
-		args = []interface{}{status, limit, offset}
+		query = "SELECT * FROM items WHERE seller_id = ? AND status = ? ORDER BY updated_at DESC LIMIT ? OFFSET ?"
+		args = []interface{}{sellerID, status, limit, offset}
 	} else {
-		query = "SELECT * FROM items ORDER BY updated_at DESC LIMIT ? OFFSET ?"
-		args = []interface{}{limit, offset}
+		query = "SELECT * FROM items WHERE seller_id = ? ORDER BY updated_at DESC LIMIT ? OFFSET ?"
+		args = []interface{}{sellerID, limit, offset}
 	}
 
 	items := []*domain.Item{}
-	err := r.db.SelectContext(ctx, &items, query, args...)
+	err = r.replicas.read(ctx, func(q sqlx.QueryerContext) error {
+		items = items[:0]
+		return sqlx.SelectContext(ctx, q, &items, query, args...)
+	})
 	if err != nil {
-		return nil, err
+		return nil, r.logError(ctx, "FindAll", err)
 	}
 
 	return items, nil
 }
 
 func (r *ItemRepository) Count(ctx context.Context, status string) (int, error) {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
 	var query string
 	var args []interface{}
 
 	if status != "" {
-		query = "SELECT COUNT(*) FROM items WHERE status = ?"
-		args = []interface{}{status}
+		query = "SELECT COUNT(*) FROM items WHERE seller_id = ? AND status = ?"
+		args = []interface{}{sellerID, status}
 	} else {
-		query = "SELECT COUNT(*) FROM items"
-		args = []interface{}{}
+		query = "SELECT COUNT(*) FROM items WHERE seller_id = ?"
+		args = []interface{}{sellerID}
 	}
 
 	var count int
-	err := r.db.GetContext(ctx, &count, query, args...)
+	err = r.replicas.read(ctx, func(q sqlx.QueryerContext) error {
+		return sqlx.GetContext(ctx, q, &count, query, args...)
+	})
 	if err != nil {
-		return 0, err
+		return 0, r.logError(ctx, "Count", err)
 	}
 
 	return count, nil
 }
 
 func (r *ItemRepository) ExistsByCode(ctx context.Context, code string, excludeID int64) (bool, error) {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
 	var query string
 	var args []interface{}
 
 	if excludeID > 0 {
-		query = "SELECT COUNT(*) FROM items WHERE code = ? AND id != ?"
-		args = []interface{}{code, excludeID}
+		query = "SELECT COUNT(*) FROM items WHERE seller_id = ? AND code = ? AND id != ?"
+		args = []interface{}{sellerID, code, excludeID}
 	} else {
-		query = "SELECT COUNT(*) FROM items WHERE code = ?"
-		args = []interface{}{code}
+		query = "SELECT COUNT(*) FROM items WHERE seller_id = ? AND code = ?"
+		args = []interface{}{sellerID, code}
 	}
 
 	var count int
-	err := r.db.GetContext(ctx, &count, query, args...)
+	err = sqlx.GetContext(ctx, queryer(ctx, r.db), &count, forUpdate(ctx, query), args...)
 	if err != nil {
-		return false, err
+		return false, r.logError(ctx, "ExistsByCode", err)
 	}
 
 	return count > 0, nil
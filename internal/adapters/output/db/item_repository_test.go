@@ -0,0 +1,236 @@
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/config"
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/services"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+)
+
+func sellerContext() context.Context {
+	return domain.ContextWithPrincipal(context.Background(), &domain.Principal{Subject: "tester", SellerID: "seller-1"})
+}
+
+func newTestItemRepository(t *testing.T, database *sqlx.DB) *ItemRepository {
+	t.Helper()
+
+	replicas, err := InitReplicaSet(database, &config.DatabaseConfig{})
+	if err != nil {
+		t.Fatalf("iniciar réplicas: %v", err)
+	}
+	return NewItemRepository(database, replicas)
+}
+
+func TestItemRepositoryCreateMapsDuplicateKey(t *testing.T) {
+	database, fake := newFakeDB(t, func(query string, _ []driver.NamedValue) (*fakeResponse, error) {
+		if strings.Contains(query, "INSERT INTO items") {
+			return nil, &mysql.MySQLError{Number: mysqlErrDuplicateEntry, Message: "Duplicate entry 'seller-1-ABC' for key 'uq_items_seller_code'"}
+		}
+		return nil, nil
+	})
+
+	repo := newTestItemRepository(t, database)
+
+	_, err := repo.Create(sellerContext(), domain.NewItem("ABC", "Item", "Descrição", "", 100, 1))
+	if !errors.Is(err, services.ErrDuplicateCode) {
+		t.Fatalf("esperava ErrDuplicateCode, obteve %v", err)
+	}
+
+	if _, commits, rollbacks := fake.counts(); commits != 0 || rollbacks != 1 {
+		t.Fatalf("esperava rollback sem commit, obteve commits=%d rollbacks=%d", commits, rollbacks)
+	}
+}
+
+func TestItemRepositoryUpdateMapsDuplicateKey(t *testing.T) {
+	database, _ := newFakeDB(t, func(query string, _ []driver.NamedValue) (*fakeResponse, error) {
+		if strings.Contains(query, "UPDATE items") {
+			return nil, &mysql.MySQLError{Number: mysqlErrDuplicateEntry, Message: "Duplicate entry"}
+		}
+		return nil, nil
+	})
+
+	repo := newTestItemRepository(t, database)
+
+	item := &domain.Item{ID: 7, Code: "XYZ", Title: "Item", Description: "Descrição", Price: 100, Stock: 1}
+	err := repo.Update(sellerContext(), item)
+	if !errors.Is(err, services.ErrDuplicateCode) {
+		t.Fatalf("esperava ErrDuplicateCode, obteve %v", err)
+	}
+}
+
+func TestItemRepositoryCreateRetriesDeadlock(t *testing.T) {
+	inserts := 0
+	database, fake := newFakeDB(t, func(query string, _ []driver.NamedValue) (*fakeResponse, error) {
+		if strings.Contains(query, "INSERT INTO items") {
+			inserts++
+			if inserts == 1 {
+				return nil, &mysql.MySQLError{Number: mysqlErrDeadlock, Message: "Deadlock found when trying to get lock"}
+			}
+			return &fakeResponse{lastInsertID: 42, rowsAffected: 1}, nil
+		}
+		return &fakeResponse{rowsAffected: 1}, nil
+	})
+
+	repo := newTestItemRepository(t, database)
+
+	item, err := repo.Create(sellerContext(), domain.NewItem("ABC", "Item", "Descrição", "", 100, 1))
+	if err != nil {
+		t.Fatalf("esperava sucesso após nova tentativa, obteve %v", err)
+	}
+	if item.ID != 42 {
+		t.Fatalf("esperava ID 42, obteve %d", item.ID)
+	}
+
+	if begins, commits, rollbacks := fake.counts(); begins != 2 || commits != 1 || rollbacks != 1 {
+		t.Fatalf("esperava 2 transações (1 rollback, 1 commit), obteve begins=%d commits=%d rollbacks=%d", begins, commits, rollbacks)
+	}
+}
+
+func TestItemRepositoryCreateKeepsEventsAcrossDeadlockRetry(t *testing.T) {
+	var outboxWrites []driver.NamedValue
+	database, _ := newFakeDB(t, func(query string, args []driver.NamedValue) (*fakeResponse, error) {
+		switch {
+		case strings.Contains(query, "INSERT INTO items"):
+			return &fakeResponse{lastInsertID: 42, rowsAffected: 1}, nil
+		case strings.Contains(query, "INSERT INTO outbox_events"):
+			outboxWrites = append(outboxWrites, args[1])
+			if len(outboxWrites) == 1 {
+				return nil, &mysql.MySQLError{Number: mysqlErrDeadlock, Message: "Deadlock found when trying to get lock"}
+			}
+		}
+		return &fakeResponse{rowsAffected: 1}, nil
+	})
+
+	repo := newTestItemRepository(t, database)
+
+	if _, err := repo.Create(sellerContext(), domain.NewItem("ABC", "Item", "Descrição", "", 100, 1)); err != nil {
+		t.Fatalf("esperava sucesso após nova tentativa, obteve %v", err)
+	}
+
+	if len(outboxWrites) != 2 {
+		t.Fatalf("esperava o evento gravado nas duas tentativas, obteve %d gravações", len(outboxWrites))
+	}
+	if itemID := outboxWrites[1].Value; itemID != int64(42) {
+		t.Fatalf("esperava evento do item 42 na nova tentativa, obteve %v", itemID)
+	}
+}
+
+func TestTxManagerRetriesDeadlockedTransaction(t *testing.T) {
+	database, fake := newFakeDB(t, func(string, []driver.NamedValue) (*fakeResponse, error) {
+		return nil, nil
+	})
+
+	manager := NewTxManager(database)
+
+	attempts := 0
+	err := manager.WithinTx(context.Background(), func(ctx context.Context) error {
+		attempts++
+		if attempts < maxTxAttempts {
+			return fmt.Errorf("erro ao criar item: %w", &mysql.MySQLError{Number: mysqlErrDeadlock})
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("esperava sucesso, obteve %v", err)
+	}
+	if attempts != maxTxAttempts {
+		t.Fatalf("esperava %d tentativas, obteve %d", maxTxAttempts, attempts)
+	}
+	if _, commits, rollbacks := fake.counts(); commits != 1 || rollbacks != maxTxAttempts-1 {
+		t.Fatalf("commits=%d rollbacks=%d", commits, rollbacks)
+	}
+}
+
+func TestTxManagerGivesUpAfterMaxAttempts(t *testing.T) {
+	database, _ := newFakeDB(t, func(string, []driver.NamedValue) (*fakeResponse, error) {
+		return nil, nil
+	})
+
+	manager := NewTxManager(database)
+
+	attempts := 0
+	err := manager.WithinTx(context.Background(), func(ctx context.Context) error {
+		attempts++
+		return &mysql.MySQLError{Number: mysqlErrDeadlock}
+	})
+	if !isMySQLError(err, mysqlErrDeadlock) {
+		t.Fatalf("esperava erro de deadlock, obteve %v", err)
+	}
+	if attempts != maxTxAttempts {
+		t.Fatalf("esperava %d tentativas, obteve %d", maxTxAttempts, attempts)
+	}
+}
+
+var itemColumns = []string{"id", "seller_id", "code", "title", "description", "category", "price", "stock", "status", "created_at", "updated_at"}
+
+func itemRow(item domain.Item) []driver.Value {
+	return []driver.Value{item.ID, item.SellerID, item.Code, item.Title, item.Description, item.Category, item.Price, item.Stock, string(item.Status), item.CreatedAt, item.UpdatedAt}
+}
+
+func TestItemRepositoryCreateOrLockByCode(t *testing.T) {
+	now := time.Now()
+	existing := domain.Item{ID: 9, SellerID: "seller-1", Code: "ABC", Title: "Antigo", Description: "Descrição", Price: 100, Stock: 1, Status: domain.ItemStatusActive, CreatedAt: now, UpdatedAt: now}
+
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		wantCreated  bool
+		wantTitle    string
+		wantLock     bool
+	}{
+		{name: "novo código", rowsAffected: 1, wantCreated: true, wantTitle: "Novo"},
+		{name: "código existente", rowsAffected: 0, wantTitle: "Antigo", wantLock: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			database, fake := newFakeDB(t, func(query string, _ []driver.NamedValue) (*fakeResponse, error) {
+				switch {
+				case strings.Contains(query, "INSERT INTO items"):
+					return &fakeResponse{lastInsertID: existing.ID, rowsAffected: tt.rowsAffected}, nil
+				case strings.Contains(query, "SELECT * FROM items"):
+					return &fakeResponse{columns: itemColumns, rows: [][]driver.Value{itemRow(existing)}}, nil
+				}
+				return &fakeResponse{rowsAffected: 1}, nil
+			})
+
+			repo := newTestItemRepository(t, database)
+
+			current, created, err := repo.CreateOrLockByCode(sellerContext(), domain.NewItem("ABC", "Novo", "Descrição", "", 200, 1))
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			if created != tt.wantCreated {
+				t.Fatalf("created = %v, esperado %v", created, tt.wantCreated)
+			}
+			if current.ID != existing.ID || current.Title != tt.wantTitle {
+				t.Fatalf("item = %d/%q, esperado %d/%q", current.ID, current.Title, existing.ID, tt.wantTitle)
+			}
+
+			var locked bool
+			for i, query := range fake.queries {
+				if strings.Contains(query, "INSERT INTO items") && i != 0 {
+					t.Fatalf("o insert deve ser o primeiro comando da transação, executado após: %q", fake.queries[:i])
+				}
+				if strings.Contains(query, "UPDATE items") {
+					t.Fatalf("o repositório não deve alterar o item existente: %q", query)
+				}
+				if strings.Contains(query, "FOR UPDATE") {
+					locked = true
+				}
+			}
+			if locked != tt.wantLock {
+				t.Fatalf("bloqueio do item existente = %v, esperado %v", locked, tt.wantLock)
+			}
+		})
+	}
+}
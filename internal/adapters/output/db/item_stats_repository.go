@@ -0,0 +1,32 @@
+package db
+
+import (
+	"context"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+type ItemStatsRepository struct {
+	replicas *ReplicaSet
+}
+
+func NewItemStatsRepository(replicas *ReplicaSet) *ItemStatsRepository {
+	return &ItemStatsRepository{
+		replicas: replicas,
+	}
+}
+
+func (r *ItemStatsRepository) StatsByStatus(ctx context.Context) ([]domain.ItemStatusStats, error) {
+	query := "SELECT status, COUNT(*) AS count, COALESCE(SUM(stock), 0) AS total_stock FROM items GROUP BY status"
+
+	stats := []domain.ItemStatusStats{}
+	err := r.replicas.read(ctx, func(q sqlx.QueryerContext) error {
+		return sqlx.SelectContext(ctx, q, &stats, query)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
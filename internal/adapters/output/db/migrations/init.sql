@@ -4,12 +4,136 @@ USE melivendas;
 
 CREATE TABLE IF NOT EXISTS items (
     id BIGINT AUTO_INCREMENT PRIMARY KEY,
-    code VARCHAR(255) NOT NULL UNIQUE,
+    seller_id VARCHAR(64) NOT NULL,
+    code VARCHAR(255) NOT NULL,
     title VARCHAR(255) NOT NULL,
     description TEXT NOT NULL,
+    category VARCHAR(100) NOT NULL DEFAULT '',
     price BIGINT NOT NULL,
     stock BIGINT NOT NULL,
     status ENUM('ACTIVE', 'INACTIVE') NOT NULL,
     created_at TIMESTAMP NOT NULL,
-    updated_at TIMESTAMP NOT NULL
+    updated_at TIMESTAMP NOT NULL,
+    UNIQUE KEY uq_items_seller_code (seller_id, code),
+    KEY idx_items_seller_status (seller_id, status, updated_at)
+);
+
+CREATE TABLE IF NOT EXISTS api_keys (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    seller_id VARCHAR(64) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    prefix VARCHAR(32) NOT NULL UNIQUE,
+    secret_hash CHAR(64) NOT NULL,
+    scopes VARCHAR(1024) NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    rotated_from BIGINT NULL,
+    created_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP NULL,
+    revoked_at TIMESTAMP NULL,
+    last_used_at TIMESTAMP NULL,
+    KEY idx_api_keys_seller (seller_id)
+);
+
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    event_id CHAR(32) NOT NULL UNIQUE,
+    aggregate_type VARCHAR(64) NOT NULL,
+    aggregate_id BIGINT NOT NULL,
+    seller_id VARCHAR(64) NOT NULL,
+    event_type VARCHAR(64) NOT NULL,
+    payload JSON NOT NULL,
+    occurred_at TIMESTAMP(6) NOT NULL,
+    available_at TIMESTAMP(6) NOT NULL,
+    published_at TIMESTAMP(6) NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NULL,
+    locked_by VARCHAR(128) NULL,
+    locked_until TIMESTAMP(6) NULL,
+    KEY idx_outbox_pending (published_at, available_at, id)
+);
+
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    seller_id VARCHAR(64) NOT NULL,
+    url VARCHAR(2048) NOT NULL,
+    event_types VARCHAR(1024) NOT NULL,
+    secret VARCHAR(255) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    consecutive_failures INT NOT NULL DEFAULT 0,
+    disabled_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    KEY idx_webhook_subscriptions_seller (seller_id, active)
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    subscription_id BIGINT NOT NULL,
+    event_id CHAR(32) NOT NULL,
+    event_type VARCHAR(64) NOT NULL,
+    redelivery_of BIGINT NULL,
+    dedup_key VARCHAR(191) NOT NULL UNIQUE,
+    payload JSON NOT NULL,
+    status ENUM('PENDING', 'SUCCEEDED', 'FAILED') NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    response_code INT NULL,
+    last_error TEXT NULL,
+    next_attempt_at TIMESTAMP(6) NOT NULL,
+    created_at TIMESTAMP(6) NOT NULL,
+    delivered_at TIMESTAMP(6) NULL,
+    locked_by VARCHAR(128) NULL,
+    locked_until TIMESTAMP(6) NULL,
+    KEY idx_webhook_deliveries_due (status, next_attempt_at),
+    KEY idx_webhook_deliveries_subscription (subscription_id, id),
+    CONSTRAINT fk_webhook_deliveries_subscription FOREIGN KEY (subscription_id)
+        REFERENCES webhook_subscriptions (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS price_history (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    item_id BIGINT NOT NULL,
+    seller_id VARCHAR(64) NOT NULL,
+    previous_price BIGINT NULL,
+    price BIGINT NOT NULL,
+    source ENUM('MANUAL', 'SCHEDULED', 'SCHEDULE_ENDED') NOT NULL,
+    scheduled_price_id BIGINT NULL,
+    changed_at TIMESTAMP(6) NOT NULL,
+    KEY idx_price_history_item (seller_id, item_id, changed_at)
+);
+
+CREATE TABLE IF NOT EXISTS scheduled_prices (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    item_id BIGINT NOT NULL,
+    seller_id VARCHAR(64) NOT NULL,
+    price BIGINT NOT NULL,
+    starts_at TIMESTAMP(6) NOT NULL,
+    ends_at TIMESTAMP(6) NULL,
+    previous_price BIGINT NULL,
+    status ENUM('PENDING', 'ACTIVE', 'COMPLETED', 'CANCELLED') NOT NULL,
+    created_at TIMESTAMP(6) NOT NULL,
+    updated_at TIMESTAMP(6) NOT NULL,
+    locked_by VARCHAR(128) NULL,
+    locked_until TIMESTAMP(6) NULL,
+    KEY idx_scheduled_prices_item (seller_id, item_id, starts_at),
+    KEY idx_scheduled_prices_starting (status, starts_at),
+    KEY idx_scheduled_prices_ending (status, ends_at)
+);
+
+CREATE TABLE IF NOT EXISTS promotions (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    seller_id VARCHAR(64) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    discount_type ENUM('PERCENTAGE', 'FIXED') NOT NULL,
+    value BIGINT NOT NULL,
+    item_ids JSON NOT NULL,
+    categories JSON NOT NULL,
+    codes JSON NOT NULL,
+    priority INT NOT NULL DEFAULT 0,
+    stackable BOOLEAN NOT NULL DEFAULT FALSE,
+    starts_at TIMESTAMP(6) NOT NULL,
+    ends_at TIMESTAMP(6) NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP(6) NOT NULL,
+    updated_at TIMESTAMP(6) NOT NULL,
+    KEY idx_promotions_seller (seller_id, active)
 );
@@ -0,0 +1,134 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+const maxOutboxErrorLength = 1024
+
+type outboxRow struct {
+	ID       int64  `db:"id"`
+	Attempts int    `db:"attempts"`
+	Payload  []byte `db:"payload"`
+}
+
+func insertOutboxEvents(ctx context.Context, tx *sqlx.Tx, events []domain.ItemEvent) error {
+	query := `
+		INSERT INTO outbox_events (event_id, aggregate_type, aggregate_id, seller_id, event_type, payload, occurred_at, available_at)
+		VALUES (?, 'item', ?, ?, ?, ?, ?, ?)
+	`
+
+	for _, event := range events {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to encode event %s: %w", event.Type, err)
+		}
+
+		_, err = tx.ExecContext(
+			ctx,
+			query,
+			event.ID,
+			event.ItemID,
+			event.SellerID,
+			event.Type,
+			payload,
+			event.OccurredAt,
+			event.OccurredAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to write event %s to outbox: %w", event.Type, err)
+		}
+	}
+
+	return nil
+}
+
+type OutboxRepository struct {
+	db *sqlx.DB
+}
+
+func NewOutboxRepository(db *sqlx.DB) *OutboxRepository {
+	return &OutboxRepository{
+		db: db,
+	}
+}
+
+func (r *OutboxRepository) ClaimPending(ctx context.Context, workerID string, limit int, lease time.Duration) ([]domain.OutboxEntry, error) {
+	now := time.Now()
+
+	claim := `
+		UPDATE outbox_events
+		SET locked_by = ?, locked_until = ?
+		WHERE published_at IS NULL
+			AND available_at <= ?
+			AND (locked_until IS NULL OR locked_until < ?)
+		ORDER BY id
+		LIMIT ?
+	`
+
+	_, err := r.db.ExecContext(ctx, claim, workerID, now.Add(lease), now, now, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT id, attempts, payload
+		FROM outbox_events
+		WHERE locked_by = ? AND locked_until > ? AND published_at IS NULL
+		ORDER BY id
+	`
+
+	rows := []outboxRow{}
+	err = r.db.SelectContext(ctx, &rows, query, workerID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]domain.OutboxEntry, 0, len(rows))
+	for _, row := range rows {
+		var event domain.ItemEvent
+		if err := json.Unmarshal(row.Payload, &event); err != nil {
+			return nil, fmt.Errorf("failed to decode outbox event %d: %w", row.ID, err)
+		}
+
+		entries = append(entries, domain.OutboxEntry{
+			ID:       row.ID,
+			Attempts: row.Attempts,
+			Event:    event,
+		})
+	}
+
+	return entries, nil
+}
+
+func (r *OutboxRepository) MarkPublished(ctx context.Context, id int64, publishedAt time.Time) error {
+	query := `
+		UPDATE outbox_events
+		SET published_at = ?, locked_by = NULL, locked_until = NULL, attempts = attempts + 1, last_error = NULL
+		WHERE id = ?
+	`
+
+	_, err := r.db.ExecContext(ctx, query, publishedAt, id)
+	return err
+}
+
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id int64, reason string, retryAt time.Time) error {
+	if len(reason) > maxOutboxErrorLength {
+		reason = reason[:maxOutboxErrorLength]
+	}
+
+	query := `
+		UPDATE outbox_events
+		SET attempts = attempts + 1, last_error = ?, available_at = ?, locked_by = NULL, locked_until = NULL
+		WHERE id = ?
+	`
+
+	_, err := r.db.ExecContext(ctx, query, reason, retryAt, id)
+	return err
+}
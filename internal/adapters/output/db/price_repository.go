@@ -0,0 +1,288 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+type priceHistoryRow struct {
+	ID               int64         `db:"id"`
+	ItemID           int64         `db:"item_id"`
+	PreviousPrice    sql.NullInt64 `db:"previous_price"`
+	Price            int64         `db:"price"`
+	Source           string        `db:"source"`
+	ScheduledPriceID sql.NullInt64 `db:"scheduled_price_id"`
+	ChangedAt        time.Time     `db:"changed_at"`
+}
+
+func (r *priceHistoryRow) toDomain() *domain.PriceHistoryEntry {
+	entry := &domain.PriceHistoryEntry{
+		ID:        r.ID,
+		ItemID:    r.ItemID,
+		Price:     r.Price,
+		Source:    domain.PriceChangeSource(r.Source),
+		ChangedAt: r.ChangedAt,
+	}
+
+	if r.PreviousPrice.Valid {
+		entry.PreviousPrice = &r.PreviousPrice.Int64
+	}
+	if r.ScheduledPriceID.Valid {
+		entry.ScheduledPriceID = &r.ScheduledPriceID.Int64
+	}
+
+	return entry
+}
+
+type scheduledPriceRow struct {
+	ID            int64         `db:"id"`
+	ItemID        int64         `db:"item_id"`
+	SellerID      string        `db:"seller_id"`
+	Price         int64         `db:"price"`
+	StartsAt      time.Time     `db:"starts_at"`
+	EndsAt        sql.NullTime  `db:"ends_at"`
+	PreviousPrice sql.NullInt64 `db:"previous_price"`
+	Status        string        `db:"status"`
+	CreatedAt     time.Time     `db:"created_at"`
+	UpdatedAt     time.Time     `db:"updated_at"`
+}
+
+func (r *scheduledPriceRow) toDomain() *domain.ScheduledPrice {
+	schedule := &domain.ScheduledPrice{
+		ID:        r.ID,
+		ItemID:    r.ItemID,
+		SellerID:  r.SellerID,
+		Price:     r.Price,
+		StartsAt:  r.StartsAt,
+		Status:    domain.ScheduledPriceStatus(r.Status),
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+
+	if r.EndsAt.Valid {
+		schedule.EndsAt = &r.EndsAt.Time
+	}
+	if r.PreviousPrice.Valid {
+		schedule.PreviousPrice = &r.PreviousPrice.Int64
+	}
+
+	return schedule
+}
+
+const scheduledPriceColumns = `
+	id, item_id, seller_id, price, starts_at, ends_at, previous_price, status, created_at, updated_at
+`
+
+func insertPriceHistory(ctx context.Context, tx *sqlx.Tx, events []domain.ItemEvent) error {
+	query := `
+		INSERT INTO price_history (item_id, seller_id, previous_price, price, source, scheduled_price_id, changed_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	for _, event := range events {
+		source := event.PriceSource
+		switch {
+		case event.Type == domain.EventItemCreated:
+			source = domain.PriceChangeManual
+		case event.Type != domain.EventItemUpdated || event.PreviousPrice == nil:
+			continue
+		case source == "":
+			source = domain.PriceChangeManual
+		}
+
+		_, err := tx.ExecContext(
+			ctx,
+			query,
+			event.ItemID,
+			event.SellerID,
+			event.PreviousPrice,
+			event.Item.Price,
+			source,
+			event.ScheduledPriceID,
+			event.OccurredAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type PriceRepository struct {
+	db *sqlx.DB
+}
+
+func NewPriceRepository(db *sqlx.DB) *PriceRepository {
+	return &PriceRepository{
+		db: db,
+	}
+}
+
+func (r *PriceRepository) FindHistory(ctx context.Context, itemID int64, limit int) ([]*domain.PriceHistoryEntry, error) {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT id, item_id, previous_price, price, source, scheduled_price_id, changed_at
+		FROM price_history
+		WHERE seller_id = ? AND item_id = ?
+		ORDER BY changed_at DESC, id DESC
+		LIMIT ?
+	`
+
+	rows := []priceHistoryRow{}
+	err = sqlx.SelectContext(ctx, queryer(ctx, r.db), &rows, query, sellerID, itemID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]*domain.PriceHistoryEntry, 0, len(rows))
+	for i := range rows {
+		history = append(history, rows[i].toDomain())
+	}
+
+	return history, nil
+}
+
+func (r *PriceRepository) CreateSchedule(ctx context.Context, schedule *domain.ScheduledPrice) (*domain.ScheduledPrice, error) {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		INSERT INTO scheduled_prices (item_id, seller_id, price, starts_at, ends_at, previous_price, status, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := execer(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		schedule.ItemID,
+		sellerID,
+		schedule.Price,
+		schedule.StartsAt,
+		schedule.EndsAt,
+		schedule.PreviousPrice,
+		schedule.Status,
+		schedule.CreatedAt,
+		schedule.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	schedule.ID = id
+	schedule.SellerID = sellerID
+	return schedule, nil
+}
+
+func (r *PriceRepository) GetSchedule(ctx context.Context, itemID, id int64) (*domain.ScheduledPrice, error) {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "SELECT " + scheduledPriceColumns + " FROM scheduled_prices WHERE id = ? AND item_id = ? AND seller_id = ?"
+
+	var row scheduledPriceRow
+	err = sqlx.GetContext(ctx, queryer(ctx, r.db), &row, forUpdate(ctx, query), id, itemID, sellerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return row.toDomain(), nil
+}
+
+func (r *PriceRepository) FindSchedules(ctx context.Context, itemID int64) ([]*domain.ScheduledPrice, error) {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "SELECT " + scheduledPriceColumns + " FROM scheduled_prices WHERE seller_id = ? AND item_id = ? ORDER BY starts_at, id"
+
+	rows := []scheduledPriceRow{}
+	err = sqlx.SelectContext(ctx, queryer(ctx, r.db), &rows, forUpdate(ctx, query), sellerID, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduledPricesFromRows(rows), nil
+}
+
+func (r *PriceRepository) UpdateSchedule(ctx context.Context, schedule *domain.ScheduledPrice) error {
+	query := `
+		UPDATE scheduled_prices
+		SET previous_price = ?, status = ?, updated_at = ?, locked_by = NULL, locked_until = NULL
+		WHERE id = ? AND seller_id = ?
+	`
+
+	_, err := execer(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		schedule.PreviousPrice,
+		schedule.Status,
+		schedule.UpdatedAt,
+		schedule.ID,
+		schedule.SellerID,
+	)
+	return err
+}
+
+func (r *PriceRepository) ClaimDueSchedules(ctx context.Context, workerID string, limit int, lease time.Duration) ([]*domain.ScheduledPrice, error) {
+	now := time.Now()
+
+	claim := `
+		UPDATE scheduled_prices
+		SET locked_by = ?, locked_until = ?
+		WHERE ((status = 'PENDING' AND starts_at <= ?) OR (status = 'ACTIVE' AND ends_at <= ?))
+			AND (locked_until IS NULL OR locked_until < ?)
+		ORDER BY starts_at, id
+		LIMIT ?
+	`
+
+	_, err := r.db.ExecContext(ctx, claim, workerID, now.Add(lease), now, now, now, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT ` + scheduledPriceColumns + `
+		FROM scheduled_prices
+		WHERE locked_by = ? AND locked_until > ? AND status IN ('PENDING', 'ACTIVE')
+		ORDER BY starts_at, id
+	`
+
+	rows := []scheduledPriceRow{}
+	err = r.db.SelectContext(ctx, &rows, query, workerID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduledPricesFromRows(rows), nil
+}
+
+func scheduledPricesFromRows(rows []scheduledPriceRow) []*domain.ScheduledPrice {
+	schedules := make([]*domain.ScheduledPrice, 0, len(rows))
+	for i := range rows {
+		schedules = append(schedules, rows[i].toDomain())
+	}
+	return schedules
+}
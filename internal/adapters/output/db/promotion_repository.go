@@ -0,0 +1,228 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+type promotionRow struct {
+	ID           int64        `db:"id"`
+	SellerID     string       `db:"seller_id"`
+	Name         string       `db:"name"`
+	DiscountType string       `db:"discount_type"`
+	Value        int64        `db:"value"`
+	ItemIDs      []byte       `db:"item_ids"`
+	Categories   []byte       `db:"categories"`
+	Codes        []byte       `db:"codes"`
+	Priority     int          `db:"priority"`
+	Stackable    bool         `db:"stackable"`
+	StartsAt     time.Time    `db:"starts_at"`
+	EndsAt       sql.NullTime `db:"ends_at"`
+	Active       bool         `db:"active"`
+	CreatedAt    time.Time    `db:"created_at"`
+	UpdatedAt    time.Time    `db:"updated_at"`
+}
+
+func (r *promotionRow) toDomain() (*domain.Promotion, error) {
+	promotion := &domain.Promotion{
+		ID:           r.ID,
+		SellerID:     r.SellerID,
+		Name:         r.Name,
+		DiscountType: domain.DiscountType(r.DiscountType),
+		Value:        r.Value,
+		ItemIDs:      []int64{},
+		Categories:   []string{},
+		Codes:        []string{},
+		Priority:     r.Priority,
+		Stackable:    r.Stackable,
+		StartsAt:     r.StartsAt,
+		Active:       r.Active,
+		CreatedAt:    r.CreatedAt,
+		UpdatedAt:    r.UpdatedAt,
+	}
+
+	if err := json.Unmarshal(r.ItemIDs, &promotion.ItemIDs); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(r.Categories, &promotion.Categories); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(r.Codes, &promotion.Codes); err != nil {
+		return nil, err
+	}
+	if r.EndsAt.Valid {
+		promotion.EndsAt = &r.EndsAt.Time
+	}
+
+	return promotion, nil
+}
+
+type PromotionRepository struct {
+	db *sqlx.DB
+}
+
+func NewPromotionRepository(db *sqlx.DB) *PromotionRepository {
+	return &PromotionRepository{
+		db: db,
+	}
+}
+
+func (r *PromotionRepository) Create(ctx context.Context, promotion *domain.Promotion) (*domain.Promotion, error) {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	itemIDs, err := json.Marshal(promotion.ItemIDs)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := json.Marshal(promotion.Categories)
+	if err != nil {
+		return nil, err
+	}
+	codes, err := json.Marshal(promotion.Codes)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		INSERT INTO promotions (seller_id, name, discount_type, value, item_ids, categories, codes, priority, stackable, starts_at, ends_at, active, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := execer(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		sellerID,
+		promotion.Name,
+		promotion.DiscountType,
+		promotion.Value,
+		itemIDs,
+		categories,
+		codes,
+		promotion.Priority,
+		promotion.Stackable,
+		promotion.StartsAt,
+		promotion.EndsAt,
+		promotion.Active,
+		promotion.CreatedAt,
+		promotion.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	promotion.ID = id
+	promotion.SellerID = sellerID
+	return promotion, nil
+}
+
+func (r *PromotionRepository) GetByID(ctx context.Context, id int64) (*domain.Promotion, error) {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var row promotionRow
+	err = sqlx.GetContext(ctx, queryer(ctx, r.db), &row, forUpdate(ctx, "SELECT * FROM promotions WHERE id = ? AND seller_id = ?"), id, sellerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return row.toDomain()
+}
+
+func (r *PromotionRepository) FindAll(ctx context.Context) ([]*domain.Promotion, error) {
+	return r.find(ctx, "SELECT * FROM promotions WHERE seller_id = ? ORDER BY priority DESC, id")
+}
+
+func (r *PromotionRepository) FindInEffect(ctx context.Context, now time.Time) ([]*domain.Promotion, error) {
+	query := `
+		SELECT * FROM promotions
+		WHERE seller_id = ? AND active = TRUE AND starts_at <= ? AND (ends_at IS NULL OR ends_at > ?)
+		ORDER BY priority DESC, id
+	`
+
+	return r.find(ctx, query, now, now)
+}
+
+func (r *PromotionRepository) LastChangedAt(ctx context.Context, now time.Time) (time.Time, error) {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	query := `
+		SELECT MAX(GREATEST(
+			updated_at,
+			IF(starts_at <= ?, starts_at, updated_at),
+			IF(ends_at <= ?, ends_at, updated_at)
+		))
+		FROM promotions
+		WHERE seller_id = ?
+	`
+
+	var changedAt sql.NullTime
+	if err := sqlx.GetContext(ctx, queryer(ctx, r.db), &changedAt, query, now, now, sellerID); err != nil {
+		return time.Time{}, err
+	}
+
+	return changedAt.Time, nil
+}
+
+func (r *PromotionRepository) find(ctx context.Context, query string, args ...interface{}) ([]*domain.Promotion, error) {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []promotionRow{}
+	err = sqlx.SelectContext(ctx, queryer(ctx, r.db), &rows, query, append([]interface{}{sellerID}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	promotions := make([]*domain.Promotion, 0, len(rows))
+	for i := range rows {
+		promotion, err := rows[i].toDomain()
+		if err != nil {
+			return nil, err
+		}
+		promotions = append(promotions, promotion)
+	}
+
+	return promotions, nil
+}
+
+func (r *PromotionRepository) Update(ctx context.Context, promotion *domain.Promotion) error {
+	query := `
+		UPDATE promotions
+		SET active = ?, updated_at = ?
+		WHERE id = ? AND seller_id = ?
+	`
+
+	_, err := execer(ctx, r.db).ExecContext(
+		ctx,
+		query,
+		promotion.Active,
+		promotion.UpdatedAt,
+		promotion.ID,
+		promotion.SellerID,
+	)
+	return err
+}
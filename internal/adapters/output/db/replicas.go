@@ -0,0 +1,190 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/XSAM/otelsql"
+	"github.com/fesbarbosa/melivendas-api/internal/config"
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/jmoiron/sqlx"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+type replica struct {
+	addr    string
+	db      *sqlx.DB
+	healthy atomic.Bool
+}
+
+type ReplicaSet struct {
+	primary  *sqlx.DB
+	replicas []*replica
+	next     atomic.Uint64
+
+	stickyWindow time.Duration
+	mu           sync.Mutex
+	lastWrites   map[string]time.Time
+}
+
+func InitReplicaSet(primary *sqlx.DB, cfg *config.DatabaseConfig) (*ReplicaSet, error) {
+	set := &ReplicaSet{
+		primary:      primary,
+		stickyWindow: cfg.ReadYourWritesWindow,
+		lastWrites:   make(map[string]time.Time),
+	}
+
+	for _, addr := range cfg.ReadReplicas {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			set.Close()
+			return nil, fmt.Errorf("invalid read replica address %q: %w", addr, err)
+		}
+
+		replicaCfg := *cfg
+		replicaCfg.Host = host
+		replicaCfg.Port = port
+
+		sqlDB, err := otelsql.Open(cfg.Driver, replicaCfg.GetDSN(), otelsql.WithAttributes(semconv.DBSystemMySQL))
+		if err != nil {
+			set.Close()
+			return nil, fmt.Errorf("failed to open read replica %s: %w", addr, err)
+		}
+
+		r := &replica{addr: addr, db: sqlx.NewDb(sqlDB, cfg.Driver)}
+		if err := r.db.Ping(); err != nil {
+			slog.Warn("Réplica de leitura indisponível; usando primário até se recuperar", "replica", addr, "error", err)
+		} else {
+			r.healthy.Store(true)
+		}
+		set.replicas = append(set.replicas, r)
+	}
+
+	return set, nil
+}
+
+func (s *ReplicaSet) reader(ctx context.Context) (*sqlx.DB, *replica) {
+	if len(s.replicas) == 0 || s.recentlyWrote(ctx) {
+		return s.primary, nil
+	}
+
+	start := s.next.Add(1)
+	for i := range s.replicas {
+		r := s.replicas[(int(start)+i)%len(s.replicas)]
+		if r.healthy.Load() {
+			return r.db, r
+		}
+	}
+
+	return s.primary, nil
+}
+
+func (s *ReplicaSet) read(ctx context.Context, fn func(q sqlx.QueryerContext) error) error {
+	if tx, ok := txFromContext(ctx); ok {
+		return fn(tx)
+	}
+
+	db, r := s.reader(ctx)
+
+	err := fn(db)
+	if err == nil || r == nil || errors.Is(err, sql.ErrNoRows) || ctx.Err() != nil {
+		return err
+	}
+
+	if r.healthy.CompareAndSwap(true, false) {
+		slog.WarnContext(ctx, "Réplica de leitura com falha; redirecionando leituras ao primário", "replica", r.addr, "error", err)
+	}
+	return fn(s.primary)
+}
+
+func (s *ReplicaSet) markWrite(ctx context.Context) {
+	if len(s.replicas) == 0 || s.stickyWindow <= 0 {
+		return
+	}
+
+	sellerID, ok := domain.SellerIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	s.lastWrites[sellerID] = time.Now()
+	s.mu.Unlock()
+}
+
+func (s *ReplicaSet) recentlyWrote(ctx context.Context) bool {
+	if s.stickyWindow <= 0 {
+		return false
+	}
+
+	sellerID, ok := domain.SellerIDFromContext(ctx)
+	if !ok {
+		return false
+	}
+
+	s.mu.Lock()
+	lastWrite, ok := s.lastWrites[sellerID]
+	s.mu.Unlock()
+
+	return ok && time.Since(lastWrite) < s.stickyWindow
+}
+
+func (s *ReplicaSet) Run(ctx context.Context, interval time.Duration) {
+	if len(s.replicas) == 0 || interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.checkReplicas(ctx)
+			s.pruneWrites()
+		}
+	}
+}
+
+func (s *ReplicaSet) checkReplicas(ctx context.Context) {
+	for _, r := range s.replicas {
+		pingCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+		err := r.db.PingContext(pingCtx)
+		cancel()
+
+		healthy := err == nil
+		if r.healthy.Swap(healthy) != healthy {
+			if healthy {
+				slog.Info("Réplica de leitura recuperada", "replica", r.addr)
+			} else {
+				slog.Warn("Réplica de leitura indisponível", "replica", r.addr, "error", err)
+			}
+		}
+	}
+}
+
+func (s *ReplicaSet) pruneWrites() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for sellerID, lastWrite := range s.lastWrites {
+		if time.Since(lastWrite) >= s.stickyWindow {
+			delete(s.lastWrites, sellerID)
+		}
+	}
+}
+
+func (s *ReplicaSet) Close() {
+	for _, r := range s.replicas {
+		_ = r.db.Close()
+	}
+}
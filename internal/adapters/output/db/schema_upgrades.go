@@ -0,0 +1,136 @@
+package db
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type upgradeStatement struct {
+	query string
+	args  []interface{}
+}
+
+type schemaUpgrade struct {
+	name       string
+	pending    func(db *sqlx.DB) (bool, error)
+	statements []upgradeStatement
+}
+
+func schemaUpgrades(legacySellerID string) []schemaUpgrade {
+	return []schemaUpgrade{
+		{
+			name:    "items.seller_id",
+			pending: columnMissing("items", "seller_id"),
+			statements: []upgradeStatement{
+				{query: "ALTER TABLE items ADD COLUMN seller_id VARCHAR(64) NULL AFTER id"},
+				{query: "UPDATE items SET seller_id = ? WHERE seller_id IS NULL", args: []interface{}{legacySellerID}},
+				{query: "ALTER TABLE items MODIFY seller_id VARCHAR(64) NOT NULL"},
+			},
+		},
+		{
+			name:    "items.uq_items_seller_code",
+			pending: indexMissing("items", "uq_items_seller_code"),
+			statements: []upgradeStatement{
+				{query: "ALTER TABLE items ADD UNIQUE KEY uq_items_seller_code (seller_id, code)"},
+			},
+		},
+		{
+			name:    "items.idx_items_seller_status",
+			pending: indexMissing("items", "idx_items_seller_status"),
+			statements: []upgradeStatement{
+				{query: "ALTER TABLE items ADD KEY idx_items_seller_status (seller_id, status, updated_at)"},
+			},
+		},
+		{
+			name:    "items.category",
+			pending: columnMissing("items", "category"),
+			statements: []upgradeStatement{
+				{query: "ALTER TABLE items ADD COLUMN category VARCHAR(100) NOT NULL DEFAULT '' AFTER description"},
+			},
+		},
+		{
+			name:    "api_keys.seller_id",
+			pending: columnMissing("api_keys", "seller_id"),
+			statements: []upgradeStatement{
+				{query: "ALTER TABLE api_keys ADD COLUMN seller_id VARCHAR(64) NULL AFTER id"},
+				{query: "UPDATE api_keys SET seller_id = ? WHERE seller_id IS NULL", args: []interface{}{legacySellerID}},
+				{query: "ALTER TABLE api_keys MODIFY seller_id VARCHAR(64) NOT NULL"},
+			},
+		},
+		{
+			name:    "api_keys.idx_api_keys_seller",
+			pending: indexMissing("api_keys", "idx_api_keys_seller"),
+			statements: []upgradeStatement{
+				{query: "ALTER TABLE api_keys ADD KEY idx_api_keys_seller (seller_id)"},
+			},
+		},
+	}
+}
+
+func runSchemaUpgrades(db *sqlx.DB, legacySellerID string) error {
+	for _, upgrade := range schemaUpgrades(legacySellerID) {
+		pending, err := upgrade.pending(db)
+		if err != nil {
+			return fmt.Errorf("error inspecting schema for upgrade %s: %w", upgrade.name, err)
+		}
+		if !pending {
+			continue
+		}
+
+		slog.Info("Applying schema upgrade", "upgrade", upgrade.name)
+		for _, statement := range upgrade.statements {
+			if _, err := db.Exec(statement.query, statement.args...); err != nil {
+				return fmt.Errorf("error applying schema upgrade %s: %w\nStatement: %s", upgrade.name, err, statement.query)
+			}
+		}
+	}
+
+	return dropGlobalItemCodeKeys(db)
+}
+
+func dropGlobalItemCodeKeys(db *sqlx.DB) error {
+	query := `
+		SELECT index_name FROM information_schema.statistics
+		WHERE table_schema = DATABASE() AND table_name = 'items' AND non_unique = 0
+		GROUP BY index_name
+		HAVING COUNT(*) = 1 AND MAX(column_name) = 'code'
+	`
+
+	var indexes []string
+	if err := db.Select(&indexes, query); err != nil {
+		return fmt.Errorf("error inspecting unique keys on items.code: %w", err)
+	}
+
+	for _, index := range indexes {
+		slog.Info("Dropping global unique key on items.code", "index", index)
+		if _, err := db.Exec(fmt.Sprintf("ALTER TABLE items DROP INDEX `%s`", index)); err != nil {
+			return fmt.Errorf("error dropping unique key %s on items.code: %w", index, err)
+		}
+	}
+
+	return nil
+}
+
+func columnMissing(table, column string) func(db *sqlx.DB) (bool, error) {
+	return func(db *sqlx.DB) (bool, error) {
+		var count int
+		err := db.Get(&count, `
+			SELECT COUNT(*) FROM information_schema.columns
+			WHERE table_schema = DATABASE() AND table_name = ? AND column_name = ?
+		`, table, column)
+		return count == 0, err
+	}
+}
+
+func indexMissing(table, index string) func(db *sqlx.DB) (bool, error) {
+	return func(db *sqlx.DB) (bool, error) {
+		var count int
+		err := db.Get(&count, `
+			SELECT COUNT(*) FROM information_schema.statistics
+			WHERE table_schema = DATABASE() AND table_name = ? AND index_name = ?
+		`, table, index)
+		return count == 0, err
+	}
+}
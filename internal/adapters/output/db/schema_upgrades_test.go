@@ -0,0 +1,78 @@
+package db
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRunSchemaUpgrades(t *testing.T) {
+	tests := []struct {
+		name       string
+		exists     int64
+		codeKeys   []string
+		wantWrites []string
+	}{
+		{
+			name:     "esquema legado recebe seller_id, categoria e chave única por vendedor",
+			codeKeys: []string{"code"},
+			wantWrites: []string{
+				"ALTER TABLE items ADD COLUMN seller_id VARCHAR(64) NULL AFTER id",
+				"UPDATE items SET seller_id = ? WHERE seller_id IS NULL",
+				"ALTER TABLE items MODIFY seller_id VARCHAR(64) NOT NULL",
+				"ALTER TABLE items ADD UNIQUE KEY uq_items_seller_code (seller_id, code)",
+				"ALTER TABLE items ADD KEY idx_items_seller_status (seller_id, status, updated_at)",
+				"ALTER TABLE items ADD COLUMN category VARCHAR(100) NOT NULL DEFAULT '' AFTER description",
+				"ALTER TABLE api_keys ADD COLUMN seller_id VARCHAR(64) NULL AFTER id",
+				"UPDATE api_keys SET seller_id = ? WHERE seller_id IS NULL",
+				"ALTER TABLE api_keys MODIFY seller_id VARCHAR(64) NOT NULL",
+				"ALTER TABLE api_keys ADD KEY idx_api_keys_seller (seller_id)",
+				"ALTER TABLE items DROP INDEX `code`",
+			},
+		},
+		{
+			name:   "esquema atual não é alterado",
+			exists: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var writes []string
+			var backfills []driver.Value
+
+			database, _ := newFakeDB(t, func(query string, args []driver.NamedValue) (*fakeResponse, error) {
+				switch {
+				case strings.Contains(query, "GROUP BY index_name"):
+					rows := make([][]driver.Value, 0, len(tt.codeKeys))
+					for _, key := range tt.codeKeys {
+						rows = append(rows, []driver.Value{key})
+					}
+					return &fakeResponse{columns: []string{"index_name"}, rows: rows}, nil
+				case strings.Contains(query, "information_schema"):
+					return &fakeResponse{columns: []string{"count"}, rows: [][]driver.Value{{tt.exists}}}, nil
+				}
+
+				writes = append(writes, query)
+				if strings.HasPrefix(query, "UPDATE") {
+					backfills = append(backfills, args[0].Value)
+				}
+				return &fakeResponse{}, nil
+			})
+
+			if err := runSchemaUpgrades(database, "legado"); err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			if !reflect.DeepEqual(writes, tt.wantWrites) {
+				t.Fatalf("comandos executados:\n%q\nesperado:\n%q", writes, tt.wantWrites)
+			}
+			for _, seller := range backfills {
+				if seller != "legado" {
+					t.Fatalf("backfill com vendedor %v, esperado %q", seller, "legado")
+				}
+			}
+		})
+	}
+}
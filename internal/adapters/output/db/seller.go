@@ -0,0 +1,15 @@
+package db
+
+import (
+	"context"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+)
+
+func sellerFromContext(ctx context.Context) (string, error) {
+	sellerID, ok := domain.SellerIDFromContext(ctx)
+	if !ok {
+		return "", domain.ErrMissingSeller
+	}
+	return sellerID, nil
+}
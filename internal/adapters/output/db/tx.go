@@ -0,0 +1,101 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+	"github.com/jmoiron/sqlx"
+)
+
+const maxTxAttempts = 3
+
+type txContextKey struct{}
+
+type TxManager struct {
+	db *sqlx.DB
+}
+
+func NewTxManager(db *sqlx.DB) *TxManager {
+	return &TxManager{
+		db: db,
+	}
+}
+
+func (m *TxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := txFromContext(ctx); ok {
+		return fn(ctx)
+	}
+
+	var hooks *output.TxHooks
+	err := withTx(ctx, m.db, func(tx *sqlx.Tx) error {
+		hooks = &output.TxHooks{}
+		txCtx := context.WithValue(output.ContextWithTxHooks(ctx, hooks), txContextKey{}, tx)
+		return fn(txCtx)
+	})
+	if err != nil {
+		return err
+	}
+
+	hooks.Committed()
+	return nil
+}
+
+func txFromContext(ctx context.Context) (*sqlx.Tx, bool) {
+	tx, ok := ctx.Value(txContextKey{}).(*sqlx.Tx)
+	return tx, ok
+}
+
+func queryer(ctx context.Context, db *sqlx.DB) sqlx.QueryerContext {
+	if tx, ok := txFromContext(ctx); ok {
+		return tx
+	}
+	return db
+}
+
+func execer(ctx context.Context, db *sqlx.DB) sqlx.ExecerContext {
+	if tx, ok := txFromContext(ctx); ok {
+		return tx
+	}
+	return db
+}
+
+func forUpdate(ctx context.Context, query string) string {
+	if _, ok := txFromContext(ctx); ok {
+		return query + " FOR UPDATE"
+	}
+	return query
+}
+
+func withTx(ctx context.Context, db *sqlx.DB, fn func(tx *sqlx.Tx) error) error {
+	if tx, ok := txFromContext(ctx); ok {
+		return fn(tx)
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := runTx(ctx, db, fn)
+		if !isMySQLError(err, mysqlErrDeadlock) || attempt == maxTxAttempts || ctx.Err() != nil {
+			return err
+		}
+		slog.WarnContext(ctx, "transaction deadlocked, retrying", "attempt", attempt, "error", err)
+	}
+}
+
+func runTx(ctx context.Context, db *sqlx.DB, fn func(tx *sqlx.Tx) error) error {
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
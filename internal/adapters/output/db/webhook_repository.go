@@ -0,0 +1,414 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+const maxWebhookErrorLength = 1024
+
+type webhookSubscriptionRow struct {
+	ID                  int64        `db:"id"`
+	SellerID            string       `db:"seller_id"`
+	URL                 string       `db:"url"`
+	EventTypes          string       `db:"event_types"`
+	Secret              string       `db:"secret"`
+	Active              bool         `db:"active"`
+	ConsecutiveFailures int          `db:"consecutive_failures"`
+	DisabledAt          sql.NullTime `db:"disabled_at"`
+	CreatedAt           time.Time    `db:"created_at"`
+	UpdatedAt           time.Time    `db:"updated_at"`
+}
+
+func (r *webhookSubscriptionRow) toDomain() *domain.WebhookSubscription {
+	subscription := &domain.WebhookSubscription{
+		ID:                  r.ID,
+		SellerID:            r.SellerID,
+		URL:                 r.URL,
+		EventTypes:          strings.Split(r.EventTypes, ","),
+		Secret:              r.Secret,
+		Active:              r.Active,
+		ConsecutiveFailures: r.ConsecutiveFailures,
+		CreatedAt:           r.CreatedAt,
+		UpdatedAt:           r.UpdatedAt,
+	}
+
+	if r.DisabledAt.Valid {
+		subscription.DisabledAt = &r.DisabledAt.Time
+	}
+
+	return subscription
+}
+
+type webhookDeliveryRow struct {
+	ID             int64          `db:"id"`
+	SubscriptionID int64          `db:"subscription_id"`
+	EventID        string         `db:"event_id"`
+	EventType      string         `db:"event_type"`
+	RedeliveryOf   sql.NullInt64  `db:"redelivery_of"`
+	Payload        []byte         `db:"payload"`
+	Status         string         `db:"status"`
+	Attempts       int            `db:"attempts"`
+	ResponseCode   sql.NullInt64  `db:"response_code"`
+	LastError      sql.NullString `db:"last_error"`
+	NextAttemptAt  time.Time      `db:"next_attempt_at"`
+	CreatedAt      time.Time      `db:"created_at"`
+	DeliveredAt    sql.NullTime   `db:"delivered_at"`
+}
+
+func (r *webhookDeliveryRow) toDomain() *domain.WebhookDelivery {
+	delivery := &domain.WebhookDelivery{
+		ID:             r.ID,
+		SubscriptionID: r.SubscriptionID,
+		EventID:        r.EventID,
+		EventType:      domain.EventType(r.EventType),
+		Payload:        r.Payload,
+		Status:         domain.WebhookDeliveryStatus(r.Status),
+		Attempts:       r.Attempts,
+		LastError:      r.LastError.String,
+		NextAttemptAt:  r.NextAttemptAt,
+		CreatedAt:      r.CreatedAt,
+	}
+
+	if r.RedeliveryOf.Valid {
+		delivery.RedeliveryOf = &r.RedeliveryOf.Int64
+	}
+	if r.ResponseCode.Valid {
+		code := int(r.ResponseCode.Int64)
+		delivery.ResponseCode = &code
+	}
+	if r.DeliveredAt.Valid {
+		delivery.DeliveredAt = &r.DeliveredAt.Time
+	}
+
+	return delivery
+}
+
+const webhookDeliveryColumns = `
+	d.id, d.subscription_id, d.event_id, d.event_type, d.redelivery_of, d.payload, d.status,
+	d.attempts, d.response_code, d.last_error, d.next_attempt_at, d.created_at, d.delivered_at
+`
+
+type WebhookRepository struct {
+	db *sqlx.DB
+}
+
+func NewWebhookRepository(db *sqlx.DB) *WebhookRepository {
+	return &WebhookRepository{
+		db: db,
+	}
+}
+
+func (r *WebhookRepository) CreateSubscription(ctx context.Context, subscription *domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		INSERT INTO webhook_subscriptions (seller_id, url, event_types, secret, active, consecutive_failures, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := r.db.ExecContext(
+		ctx,
+		query,
+		sellerID,
+		subscription.URL,
+		strings.Join(subscription.EventTypes, ","),
+		subscription.Secret,
+		subscription.Active,
+		subscription.ConsecutiveFailures,
+		subscription.CreatedAt,
+		subscription.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	subscription.ID = id
+	subscription.SellerID = sellerID
+	return subscription, nil
+}
+
+func (r *WebhookRepository) GetSubscription(ctx context.Context, id int64) (*domain.WebhookSubscription, error) {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "SELECT * FROM webhook_subscriptions WHERE id = ? AND seller_id = ?"
+
+	var row webhookSubscriptionRow
+	err = r.db.GetContext(ctx, &row, query, id, sellerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return row.toDomain(), nil
+}
+
+func (r *WebhookRepository) FindSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error) {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.findSubscriptions(ctx, "SELECT * FROM webhook_subscriptions WHERE seller_id = ? ORDER BY id", sellerID)
+}
+
+func (r *WebhookRepository) FindActiveSubscriptions(ctx context.Context, sellerID string) ([]*domain.WebhookSubscription, error) {
+	return r.findSubscriptions(ctx, "SELECT * FROM webhook_subscriptions WHERE seller_id = ? AND active = TRUE ORDER BY id", sellerID)
+}
+
+func (r *WebhookRepository) findSubscriptions(ctx context.Context, query string, args ...interface{}) ([]*domain.WebhookSubscription, error) {
+	rows := []webhookSubscriptionRow{}
+	err := r.db.SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions := make([]*domain.WebhookSubscription, 0, len(rows))
+	for i := range rows {
+		subscriptions = append(subscriptions, rows[i].toDomain())
+	}
+
+	return subscriptions, nil
+}
+
+func (r *WebhookRepository) DeleteSubscription(ctx context.Context, id int64) error {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := "DELETE FROM webhook_subscriptions WHERE id = ? AND seller_id = ?"
+
+	_, err = r.db.ExecContext(ctx, query, id, sellerID)
+	return err
+}
+
+func (r *WebhookRepository) UpdateSubscriptionHealth(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	query := `
+		UPDATE webhook_subscriptions
+		SET active = ?, consecutive_failures = ?, disabled_at = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		subscription.Active,
+		subscription.ConsecutiveFailures,
+		subscription.DisabledAt,
+		subscription.UpdatedAt,
+		subscription.ID,
+	)
+	return err
+}
+
+func (r *WebhookRepository) CreateDelivery(ctx context.Context, delivery *domain.WebhookDelivery) (*domain.WebhookDelivery, error) {
+	dedupKey := fmt.Sprintf("%d:%s", delivery.SubscriptionID, delivery.EventID)
+	if delivery.RedeliveryOf != nil {
+		dedupKey = fmt.Sprintf("%s:r%d:%d", dedupKey, *delivery.RedeliveryOf, delivery.CreatedAt.UnixNano())
+	}
+
+	query := `
+		INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, redelivery_of, dedup_key, payload, status, attempts, next_attempt_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE id = LAST_INSERT_ID(id)
+	`
+
+	result, err := r.db.ExecContext(
+		ctx,
+		query,
+		delivery.SubscriptionID,
+		delivery.EventID,
+		delivery.EventType,
+		delivery.RedeliveryOf,
+		dedupKey,
+		delivery.Payload,
+		delivery.Status,
+		delivery.Attempts,
+		delivery.NextAttemptAt,
+		delivery.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	delivery.ID = id
+	return delivery, nil
+}
+
+func (r *WebhookRepository) GetDelivery(ctx context.Context, subscriptionID, id int64) (*domain.WebhookDelivery, error) {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries d
+		JOIN webhook_subscriptions s ON s.id = d.subscription_id
+		WHERE d.id = ? AND d.subscription_id = ? AND s.seller_id = ?
+	`
+
+	var row webhookDeliveryRow
+	err = r.db.GetContext(ctx, &row, query, id, subscriptionID, sellerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return row.toDomain(), nil
+}
+
+func (r *WebhookRepository) FindDeliveries(ctx context.Context, subscriptionID int64, limit int) ([]*domain.WebhookDelivery, error) {
+	sellerID, err := sellerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries d
+		JOIN webhook_subscriptions s ON s.id = d.subscription_id
+		WHERE d.subscription_id = ? AND s.seller_id = ?
+		ORDER BY d.id DESC
+		LIMIT ?
+	`
+
+	rows := []webhookDeliveryRow{}
+	err = r.db.SelectContext(ctx, &rows, query, subscriptionID, sellerID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*domain.WebhookDelivery, 0, len(rows))
+	for i := range rows {
+		deliveries = append(deliveries, rows[i].toDomain())
+	}
+
+	return deliveries, nil
+}
+
+func (r *WebhookRepository) ClaimDueDeliveries(ctx context.Context, workerID string, limit int, lease time.Duration) ([]domain.WebhookDeliveryJob, error) {
+	now := time.Now()
+
+	claim := `
+		UPDATE webhook_deliveries
+		SET locked_by = ?, locked_until = ?
+		WHERE status = 'PENDING'
+			AND next_attempt_at <= ?
+			AND (locked_until IS NULL OR locked_until < ?)
+		ORDER BY next_attempt_at, id
+		LIMIT ?
+	`
+
+	_, err := r.db.ExecContext(ctx, claim, workerID, now.Add(lease), now, now, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT ` + webhookDeliveryColumns + `
+		FROM webhook_deliveries d
+		WHERE d.locked_by = ? AND d.locked_until > ? AND d.status = 'PENDING'
+		ORDER BY d.next_attempt_at, d.id
+	`
+
+	rows := []webhookDeliveryRow{}
+	err = r.db.SelectContext(ctx, &rows, query, workerID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	subscriptionIDs := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		subscriptionIDs = append(subscriptionIDs, row.SubscriptionID)
+	}
+
+	subscriptionsQuery, args, err := sqlx.In("SELECT * FROM webhook_subscriptions WHERE id IN (?)", subscriptionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionRows := []webhookSubscriptionRow{}
+	err = r.db.SelectContext(ctx, &subscriptionRows, r.db.Rebind(subscriptionsQuery), args...)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions := make(map[int64]*domain.WebhookSubscription, len(subscriptionRows))
+	for i := range subscriptionRows {
+		subscriptions[subscriptionRows[i].ID] = subscriptionRows[i].toDomain()
+	}
+
+	jobs := make([]domain.WebhookDeliveryJob, 0, len(rows))
+	for i := range rows {
+		subscription, ok := subscriptions[rows[i].SubscriptionID]
+		if !ok {
+			continue
+		}
+		jobs = append(jobs, domain.WebhookDeliveryJob{
+			Delivery:     rows[i].toDomain(),
+			Subscription: subscription,
+		})
+	}
+
+	return jobs, nil
+}
+
+func (r *WebhookRepository) UpdateDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	lastError := delivery.LastError
+	if len(lastError) > maxWebhookErrorLength {
+		lastError = lastError[:maxWebhookErrorLength]
+	}
+
+	query := `
+		UPDATE webhook_deliveries
+		SET status = ?, attempts = ?, response_code = ?, last_error = ?, next_attempt_at = ?, delivered_at = ?,
+			locked_by = NULL, locked_until = NULL
+		WHERE id = ?
+	`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		query,
+		delivery.Status,
+		delivery.Attempts,
+		delivery.ResponseCode,
+		lastError,
+		delivery.NextAttemptAt,
+		delivery.DeliveredAt,
+		delivery.ID,
+	)
+	return err
+}
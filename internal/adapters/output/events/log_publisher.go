@@ -0,0 +1,29 @@
+package events
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+)
+
+type LogPublisher struct {
+	logger *slog.Logger
+}
+
+func NewLogPublisher(logger *slog.Logger) *LogPublisher {
+	return &LogPublisher{
+		logger: logger,
+	}
+}
+
+func (p *LogPublisher) Publish(ctx context.Context, event domain.ItemEvent) error {
+	p.logger.InfoContext(ctx, "evento de domínio publicado",
+		"event_id", event.ID,
+		"event_type", event.Type,
+		"item_id", event.ItemID,
+		"seller_id", event.SellerID,
+		"occurred_at", event.OccurredAt,
+	)
+	return nil
+}
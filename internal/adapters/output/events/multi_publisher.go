@@ -0,0 +1,29 @@
+package events
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+)
+
+type MultiPublisher struct {
+	publishers []output.EventPublisher
+}
+
+func NewMultiPublisher(publishers ...output.EventPublisher) *MultiPublisher {
+	return &MultiPublisher{
+		publishers: publishers,
+	}
+}
+
+func (p *MultiPublisher) Publish(ctx context.Context, event domain.ItemEvent) error {
+	var errs []error
+	for _, publisher := range p.publishers {
+		if err := publisher.Publish(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
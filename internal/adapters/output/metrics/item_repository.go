@@ -0,0 +1,101 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type InstrumentedItemRepository struct {
+	next     output.ItemRepository
+	duration *prometheus.HistogramVec
+}
+
+func NewInstrumentedItemRepository(next output.ItemRepository, registerer prometheus.Registerer) *InstrumentedItemRepository {
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "melivendas",
+		Name:      "repository_query_duration_seconds",
+		Help:      "Duração das chamadas ao repositório de itens por método e resultado.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"repository", "method", "outcome"})
+	registerer.MustRegister(duration)
+
+	return &InstrumentedItemRepository{
+		next:     next,
+		duration: duration,
+	}
+}
+
+func (r *InstrumentedItemRepository) observe(method string, start time.Time, err error) {
+	outcome := "success"
+	if err != nil {
+		outcome = "error"
+	}
+	r.duration.WithLabelValues("item", method, outcome).Observe(time.Since(start).Seconds())
+}
+
+func (r *InstrumentedItemRepository) Create(ctx context.Context, item *domain.Item) (*domain.Item, error) {
+	start := time.Now()
+	created, err := r.next.Create(ctx, item)
+	r.observe("Create", start, err)
+	return created, err
+}
+
+func (r *InstrumentedItemRepository) GetByID(ctx context.Context, id int64) (*domain.Item, error) {
+	start := time.Now()
+	item, err := r.next.GetByID(ctx, id)
+	r.observe("GetByID", start, err)
+	return item, err
+}
+
+func (r *InstrumentedItemRepository) GetByCode(ctx context.Context, code string) (*domain.Item, error) {
+	start := time.Now()
+	item, err := r.next.GetByCode(ctx, code)
+	r.observe("GetByCode", start, err)
+	return item, err
+}
+
+func (r *InstrumentedItemRepository) CreateOrLockByCode(ctx context.Context, item *domain.Item) (*domain.Item, bool, error) {
+	start := time.Now()
+	current, created, err := r.next.CreateOrLockByCode(ctx, item)
+	r.observe("CreateOrLockByCode", start, err)
+	return current, created, err
+}
+
+func (r *InstrumentedItemRepository) Update(ctx context.Context, item *domain.Item) error {
+	start := time.Now()
+	err := r.next.Update(ctx, item)
+	r.observe("Update", start, err)
+	return err
+}
+
+func (r *InstrumentedItemRepository) Delete(ctx context.Context, item *domain.Item) error {
+	start := time.Now()
+	err := r.next.Delete(ctx, item)
+	r.observe("Delete", start, err)
+	return err
+}
+
+func (r *InstrumentedItemRepository) FindAll(ctx context.Context, status string, limit, offset int) ([]*domain.Item, error) {
+	start := time.Now()
+	items, err := r.next.FindAll(ctx, status, limit, offset)
+	r.observe("FindAll", start, err)
+	return items, err
+}
+
+func (r *InstrumentedItemRepository) Count(ctx context.Context, status string) (int, error) {
+	start := time.Now()
+	count, err := r.next.Count(ctx, status)
+	r.observe("Count", start, err)
+	return count, err
+}
+
+func (r *InstrumentedItemRepository) ExistsByCode(ctx context.Context, code string, excludeID int64) (bool, error) {
+	start := time.Now()
+	exists, err := r.next.ExistsByCode(ctx, code, excludeID)
+	r.observe("ExistsByCode", start, err)
+	return exists, err
+}
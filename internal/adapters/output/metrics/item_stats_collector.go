@@ -0,0 +1,94 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	itemStatsTimeout = 3 * time.Second
+	itemStatsTTL     = 30 * time.Second
+)
+
+type ItemStatsSource interface {
+	StatsByStatus(ctx context.Context) ([]domain.ItemStatusStats, error)
+}
+
+type ItemStatsCollector struct {
+	source     ItemStatsSource
+	items      *prometheus.Desc
+	totalStock *prometheus.Desc
+
+	mu        sync.Mutex
+	stats     []domain.ItemStatusStats
+	fetchedAt time.Time
+}
+
+func NewItemStatsCollector(source ItemStatsSource) *ItemStatsCollector {
+	return &ItemStatsCollector{
+		source: source,
+		items: prometheus.NewDesc(
+			"melivendas_items",
+			"Quantidade de itens por status.",
+			[]string{"status"}, nil,
+		),
+		totalStock: prometheus.NewDesc(
+			"melivendas_items_stock_total",
+			"Estoque total somado por status de item.",
+			[]string{"status"}, nil,
+		),
+	}
+}
+
+func (c *ItemStatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.items
+	ch <- c.totalStock
+}
+
+func (c *ItemStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	stats, err := c.load()
+	if err != nil {
+		slog.Error("falha ao coletar estatísticas de itens", "error", err)
+		ch <- prometheus.NewInvalidMetric(c.items, err)
+		return
+	}
+
+	byStatus := map[domain.ItemStatus]domain.ItemStatusStats{
+		domain.ItemStatusActive:   {Status: domain.ItemStatusActive},
+		domain.ItemStatusInactive: {Status: domain.ItemStatusInactive},
+	}
+	for _, s := range stats {
+		byStatus[s.Status] = s
+	}
+
+	for status, s := range byStatus {
+		ch <- prometheus.MustNewConstMetric(c.items, prometheus.GaugeValue, float64(s.Count), string(status))
+		ch <- prometheus.MustNewConstMetric(c.totalStock, prometheus.GaugeValue, float64(s.TotalStock), string(status))
+	}
+}
+
+func (c *ItemStatsCollector) load() ([]domain.ItemStatusStats, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.stats != nil && time.Since(c.fetchedAt) < itemStatsTTL {
+		return c.stats, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), itemStatsTimeout)
+	defer cancel()
+
+	stats, err := c.source.StatsByStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.stats = stats
+	c.fetchedAt = time.Now()
+	return stats, nil
+}
@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type countingStatsSource struct {
+	calls int
+	err   error
+}
+
+func (s *countingStatsSource) StatsByStatus(ctx context.Context) ([]domain.ItemStatusStats, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return []domain.ItemStatusStats{{Status: domain.ItemStatusActive, Count: 3, TotalStock: 12}}, nil
+}
+
+func TestItemStatsCollectorCachesBetweenScrapes(t *testing.T) {
+	source := &countingStatsSource{}
+	collector := NewItemStatsCollector(source)
+
+	for i := 0; i < 3; i++ {
+		if got := testutil.CollectAndCount(collector, "melivendas_items"); got != 2 {
+			t.Fatalf("métricas = %d, esperado 2", got)
+		}
+	}
+
+	if source.calls != 1 {
+		t.Fatalf("consultas ao banco = %d, esperado 1 dentro do TTL", source.calls)
+	}
+}
+
+func TestItemStatsCollectorDoesNotCacheErrors(t *testing.T) {
+	source := &countingStatsSource{err: errors.New("banco indisponível")}
+	collector := NewItemStatsCollector(source)
+
+	if _, err := collector.load(); err == nil {
+		t.Fatal("esperava erro da fonte")
+	}
+
+	source.err = nil
+	if _, err := collector.load(); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if source.calls != 2 {
+		t.Fatalf("consultas ao banco = %d, esperado 2", source.calls)
+	}
+}
@@ -0,0 +1,79 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+	"github.com/redis/go-redis/v9"
+)
+
+var tokenBucketScript = redis.NewScript(`
+local burst = tonumber(ARGV[2])
+local rate = tonumber(ARGV[1])
+local now = tonumber(ARGV[3])
+local ttl = tonumber(ARGV[4])
+
+local state = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(state[1]) or burst
+local ts = tonumber(state[2]) or now
+
+local elapsed = math.max(0, now - ts) / 1000
+tokens = math.min(burst, tokens + elapsed * rate)
+
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "ts", now)
+redis.call("PEXPIRE", KEYS[1], ttl)
+
+return {allowed, tostring(tokens)}
+`)
+
+type RedisStore struct {
+	client    redis.UniversalClient
+	keyPrefix string
+}
+
+func NewRedisStore(client redis.UniversalClient, keyPrefix string) *RedisStore {
+	return &RedisStore{
+		client:    client,
+		keyPrefix: keyPrefix,
+	}
+}
+
+func (s *RedisStore) Take(ctx context.Context, key string, limit output.RateLimit) (*output.RateLimitResult, error) {
+	ttl := int64(math.Ceil(float64(limit.Burst)/limit.Rate*1000)) + 1000
+
+	values, err := tokenBucketScript.Run(
+		ctx,
+		s.client,
+		[]string{s.keyPrefix + key},
+		limit.Rate,
+		limit.Burst,
+		time.Now().UnixMilli(),
+		ttl,
+	).Slice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to run rate limit script: %w", err)
+	}
+
+	if len(values) != 2 {
+		return nil, fmt.Errorf("unexpected rate limit script result: %v", values)
+	}
+
+	allowed, _ := values[0].(int64)
+	tokensStr, _ := values[1].(string)
+	tokens, err := strconv.ParseFloat(tokensStr, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rate limit token count %q: %w", tokensStr, err)
+	}
+
+	return newResult(limit, tokens, allowed == 1), nil
+}
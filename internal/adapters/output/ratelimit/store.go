@@ -0,0 +1,37 @@
+package ratelimit
+
+import (
+	"math"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+)
+
+func refill(limit output.RateLimit, tokens float64, elapsed time.Duration) float64 {
+	if elapsed > 0 {
+		tokens += elapsed.Seconds() * limit.Rate
+	}
+	return math.Min(float64(limit.Burst), tokens)
+}
+
+func newResult(limit output.RateLimit, tokens float64, allowed bool) *output.RateLimitResult {
+	result := &output.RateLimitResult{
+		Allowed:    allowed,
+		Limit:      limit.Burst,
+		Remaining:  int(math.Max(0, math.Floor(tokens))),
+		ResetAfter: secondsToDuration((float64(limit.Burst) - tokens) / limit.Rate),
+	}
+
+	if !allowed {
+		result.RetryAfter = secondsToDuration((1 - tokens) / limit.Rate)
+	}
+
+	return result
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	if seconds <= 0 {
+		return 0
+	}
+	return time.Duration(math.Ceil(seconds * float64(time.Second)))
+}
@@ -0,0 +1,118 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/fesbarbosa/melivendas-api/internal/adapters/output/tracing"
+
+type TracedItemRepository struct {
+	next   output.ItemRepository
+	tracer trace.Tracer
+}
+
+func NewTracedItemRepository(next output.ItemRepository) *TracedItemRepository {
+	return &TracedItemRepository{
+		next:   next,
+		tracer: otel.Tracer(tracerName),
+	}
+}
+
+func (r *TracedItemRepository) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	attrs = append(attrs, attribute.String("repository.method", method))
+	if sellerID, ok := domain.SellerIDFromContext(ctx); ok {
+		attrs = append(attrs, attribute.String("seller.id", sellerID))
+	}
+
+	return r.tracer.Start(ctx, "ItemRepository."+method,
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+func end(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func (r *TracedItemRepository) Create(ctx context.Context, item *domain.Item) (*domain.Item, error) {
+	ctx, span := r.start(ctx, "Create", attribute.String("item.code", item.Code))
+	created, err := r.next.Create(ctx, item)
+	end(span, err)
+	return created, err
+}
+
+func (r *TracedItemRepository) GetByID(ctx context.Context, id int64) (*domain.Item, error) {
+	ctx, span := r.start(ctx, "GetByID", attribute.Int64("item.id", id))
+	item, err := r.next.GetByID(ctx, id)
+	end(span, err)
+	return item, err
+}
+
+func (r *TracedItemRepository) GetByCode(ctx context.Context, code string) (*domain.Item, error) {
+	ctx, span := r.start(ctx, "GetByCode", attribute.String("item.code", code))
+	item, err := r.next.GetByCode(ctx, code)
+	end(span, err)
+	return item, err
+}
+
+func (r *TracedItemRepository) CreateOrLockByCode(ctx context.Context, item *domain.Item) (*domain.Item, bool, error) {
+	ctx, span := r.start(ctx, "CreateOrLockByCode", attribute.String("item.code", item.Code))
+	current, created, err := r.next.CreateOrLockByCode(ctx, item)
+	span.SetAttributes(attribute.Bool("item.created", created))
+	end(span, err)
+	return current, created, err
+}
+
+func (r *TracedItemRepository) Update(ctx context.Context, item *domain.Item) error {
+	ctx, span := r.start(ctx, "Update", attribute.Int64("item.id", item.ID))
+	err := r.next.Update(ctx, item)
+	end(span, err)
+	return err
+}
+
+func (r *TracedItemRepository) Delete(ctx context.Context, item *domain.Item) error {
+	ctx, span := r.start(ctx, "Delete", attribute.Int64("item.id", item.ID))
+	err := r.next.Delete(ctx, item)
+	end(span, err)
+	return err
+}
+
+func (r *TracedItemRepository) FindAll(ctx context.Context, status string, limit, offset int) ([]*domain.Item, error) {
+	ctx, span := r.start(ctx, "FindAll",
+		attribute.String("item.status", status),
+		attribute.Int("query.limit", limit),
+		attribute.Int("query.offset", offset),
+	)
+	items, err := r.next.FindAll(ctx, status, limit, offset)
+	span.SetAttributes(attribute.Int("query.rows", len(items)))
+	end(span, err)
+	return items, err
+}
+
+func (r *TracedItemRepository) Count(ctx context.Context, status string) (int, error) {
+	ctx, span := r.start(ctx, "Count", attribute.String("item.status", status))
+	count, err := r.next.Count(ctx, status)
+	end(span, err)
+	return count, err
+}
+
+func (r *TracedItemRepository) ExistsByCode(ctx context.Context, code string, excludeID int64) (bool, error) {
+	ctx, span := r.start(ctx, "ExistsByCode",
+		attribute.String("item.code", code),
+		attribute.Int64("item.exclude_id", excludeID),
+	)
+	exists, err := r.next.ExistsByCode(ctx, code, excludeID)
+	end(span, err)
+	return exists, err
+}
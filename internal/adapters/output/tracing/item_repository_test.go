@@ -0,0 +1,112 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var errLookup = errors.New("falha na consulta")
+
+type stubItemRepository struct {
+	output.ItemRepository
+}
+
+func (stubItemRepository) GetByID(ctx context.Context, id int64) (*domain.Item, error) {
+	return &domain.Item{ID: id}, nil
+}
+
+func (stubItemRepository) GetByCode(ctx context.Context, code string) (*domain.Item, error) {
+	return nil, errLookup
+}
+
+func newInMemoryTracer(t *testing.T) *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+		_ = provider.Shutdown(context.Background())
+	})
+
+	return exporter
+}
+
+func TestTracedItemRepositoryExportsSpans(t *testing.T) {
+	exporter := newInMemoryTracer(t)
+	repo := NewTracedItemRepository(stubItemRepository{})
+	ctx := domain.ContextWithPrincipal(context.Background(), &domain.Principal{Subject: "user-1", SellerID: "seller-1"})
+
+	if _, err := repo.GetByID(ctx, 42); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if _, err := repo.GetByCode(ctx, "ABC"); !errors.Is(err, errLookup) {
+		t.Fatalf("esperava errLookup, obteve %v", err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("spans exportados = %d, esperado 2", len(spans))
+	}
+
+	tests := []struct {
+		name       string
+		attributes []attribute.KeyValue
+		status     codes.Code
+		events     int
+	}{
+		{
+			name: "ItemRepository.GetByID",
+			attributes: []attribute.KeyValue{
+				attribute.Int64("item.id", 42),
+				attribute.String("repository.method", "GetByID"),
+				attribute.String("seller.id", "seller-1"),
+			},
+			status: codes.Unset,
+		},
+		{
+			name: "ItemRepository.GetByCode",
+			attributes: []attribute.KeyValue{
+				attribute.String("item.code", "ABC"),
+				attribute.String("repository.method", "GetByCode"),
+				attribute.String("seller.id", "seller-1"),
+			},
+			status: codes.Error,
+			events: 1,
+		},
+	}
+
+	for i, tt := range tests {
+		span := spans[i]
+		if span.Name != tt.name {
+			t.Fatalf("span %d = %q, esperado %q", i, span.Name, tt.name)
+		}
+
+		got := make(map[attribute.Key]attribute.Value, len(span.Attributes))
+		for _, attr := range span.Attributes {
+			got[attr.Key] = attr.Value
+		}
+		for _, want := range tt.attributes {
+			if value, ok := got[want.Key]; !ok || value != want.Value {
+				t.Errorf("%s: atributo %s = %v, esperado %v", tt.name, want.Key, value.Emit(), want.Value.Emit())
+			}
+		}
+
+		if span.Status.Code != tt.status {
+			t.Errorf("%s: status = %v, esperado %v", tt.name, span.Status.Code, tt.status)
+		}
+		if len(span.Events) != tt.events {
+			t.Errorf("%s: eventos = %d, esperado %d", tt.name, len(span.Events), tt.events)
+		}
+	}
+}
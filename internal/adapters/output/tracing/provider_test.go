@@ -0,0 +1,76 @@
+package tracing
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fesbarbosa/melivendas-api/internal/config"
+	"go.opentelemetry.io/otel"
+)
+
+func TestNewTracerProviderExportsToOTLPEndpoint(t *testing.T) {
+	type received struct {
+		path        string
+		contentType string
+		size        int
+	}
+	requests := make(chan received, 10)
+
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- received{path: r.URL.Path, contentType: r.Header.Get("Content-Type"), size: len(body)}
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer collector.Close()
+
+	t.Setenv("TRACING_EXPORTER", "otlp")
+	t.Setenv("TRACING_OTLP_ENDPOINT", strings.TrimPrefix(collector.URL, "http://"))
+	t.Setenv("TRACING_OTLP_INSECURE", "true")
+	t.Setenv("TRACING_SAMPLE_RATIO", "1")
+
+	previous := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	ctx := context.Background()
+	provider, err := NewTracerProvider(ctx, &config.NewConfig().Tracing)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	_, span := otel.Tracer(tracerName).Start(ctx, "teste")
+	span.End()
+
+	if err := provider.ForceFlush(ctx); err != nil {
+		t.Fatalf("falha ao exportar spans: %v", err)
+	}
+	if err := provider.Shutdown(ctx); err != nil {
+		t.Fatalf("falha ao encerrar o provider: %v", err)
+	}
+
+	select {
+	case req := <-requests:
+		if req.path != "/v1/traces" {
+			t.Errorf("caminho = %q, esperado /v1/traces", req.path)
+		}
+		if req.contentType != "application/x-protobuf" {
+			t.Errorf("Content-Type = %q", req.contentType)
+		}
+		if req.size == 0 {
+			t.Error("o coletor recebeu um corpo vazio")
+		}
+	default:
+		t.Fatal("o coletor não recebeu nenhuma exportação")
+	}
+}
+
+func TestNewTracerProviderRejectsUnknownExporter(t *testing.T) {
+	_, err := NewTracerProvider(context.Background(), &config.TracingConfig{Exporter: "zipkin", ServiceName: "teste"})
+	if err == nil {
+		t.Fatal("esperava erro para exportador desconhecido")
+	}
+}
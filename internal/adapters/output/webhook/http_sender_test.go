@@ -0,0 +1,62 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRejectInternalAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		blocked bool
+	}{
+		{address: "127.0.0.1:80", blocked: true},
+		{address: "10.1.2.3:443", blocked: true},
+		{address: "172.16.0.10:443", blocked: true},
+		{address: "192.168.0.1:8080", blocked: true},
+		{address: "169.254.169.254:80", blocked: true},
+		{address: "100.100.100.200:80", blocked: true},
+		{address: "0.0.0.0:80", blocked: true},
+		{address: "[::1]:80", blocked: true},
+		{address: "[fe80::1]:80", blocked: true},
+		{address: "[fd00::1]:80", blocked: true},
+		{address: "[::ffff:127.0.0.1]:80", blocked: true},
+		{address: "93.184.216.34:443", blocked: false},
+		{address: "[2606:2800:220:1:248:1893:25c8:1946]:443", blocked: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			err := rejectInternalAddress("tcp", tt.address, nil)
+			if blocked := errors.Is(err, ErrInternalAddress); blocked != tt.blocked {
+				t.Fatalf("bloqueado = %v, esperado %v (erro: %v)", blocked, tt.blocked, err)
+			}
+		})
+	}
+}
+
+func TestHTTPSenderRefusesToDialInternalAddresses(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+	}))
+	defer server.Close()
+
+	_, err := NewHTTPSender(time.Second, false).Send(context.Background(), server.URL, nil, []byte(`{}`))
+	if !errors.Is(err, ErrInternalAddress) {
+		t.Fatalf("esperava ErrInternalAddress, obteve %v", err)
+	}
+	if hits.Load() != 0 {
+		t.Fatalf("o servidor interno recebeu %d requisições", hits.Load())
+	}
+
+	status, err := NewHTTPSender(time.Second, true).Send(context.Background(), server.URL, nil, []byte(`{}`))
+	if err != nil || status != http.StatusOK {
+		t.Fatalf("com destinos internos liberados: status=%d erro=%v", status, err)
+	}
+}
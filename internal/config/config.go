@@ -1,16 +1,43 @@
 package config
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
+	Server    ServerConfig
+	GRPC      GRPCConfig
+	Database  DatabaseConfig
+	Auth      AuthConfig
+	RateLimit RateLimitConfig
+	Tracing   TracingConfig
+	Log       LogConfig
+	Outbox    OutboxConfig
+	Prices    PriceSchedulerConfig
+	Webhooks  WebhookConfig
+	Stream    StreamConfig
+	OpenAPI   OpenAPIConfig
+	Errors    ErrorsConfig
+	Cache     CacheConfig
+	HTTPCache HTTPCacheConfig
 }
 
 type ServerConfig struct {
-	Port string
+	Port            string
+	ShutdownDrain   time.Duration
+	ShutdownTimeout time.Duration
+	TrustedProxies  []string
+}
+
+type GRPCConfig struct {
+	Enabled    bool
+	Port       string
+	Reflection bool
 }
 
 type DatabaseConfig struct {
@@ -20,6 +47,98 @@ type DatabaseConfig struct {
 	User     string
 	Password string
 	DBName   string
+
+	ReadReplicas         []string
+	ReplicaCheckInterval time.Duration
+	ReadYourWritesWindow time.Duration
+
+	LegacySellerID string
+}
+
+type AuthConfig struct {
+	Enabled         bool
+	HMACSecret      string
+	JWKSFile        string
+	Issuer          string
+	Audience        string
+	PublicRoutes    []string
+	DefaultSellerID string
+}
+
+type RateLimitConfig struct {
+	Enabled    bool
+	Store      string
+	RedisAddr  string
+	ReadRate   float64
+	ReadBurst  int
+	WriteRate  float64
+	WriteBurst int
+	IPRate     float64
+	IPBurst    int
+}
+
+type TracingConfig struct {
+	ServiceName  string
+	Exporter     string
+	OTLPEndpoint string
+	OTLPInsecure bool
+	SampleRatio  float64
+}
+
+type LogConfig struct {
+	Level  string
+	Format string
+}
+
+type OutboxConfig struct {
+	Enabled      bool
+	PollInterval time.Duration
+	BatchSize    int
+	Lease        time.Duration
+}
+
+type PriceSchedulerConfig struct {
+	Enabled      bool
+	PollInterval time.Duration
+	BatchSize    int
+	Lease        time.Duration
+}
+
+type WebhookConfig struct {
+	Enabled      bool
+	PollInterval time.Duration
+	BatchSize    int
+	Lease        time.Duration
+	Timeout      time.Duration
+	MaxAttempts  int
+	BaseBackoff  time.Duration
+	DisableAfter int
+
+	AllowInternalTargets bool
+}
+
+type StreamConfig struct {
+	ReplayBuffer int
+	Heartbeat    time.Duration
+}
+
+type OpenAPIConfig struct {
+	ValidateRequests  bool
+	ValidateResponses bool
+}
+
+type ErrorsConfig struct {
+	ProblemJSON bool
+}
+
+type CacheConfig struct {
+	Enabled bool
+	Size    int
+	TTL     time.Duration
+}
+
+type HTTPCacheConfig struct {
+	CacheControl string
 }
 
 func (c *DatabaseConfig) GetDSN() string {
@@ -30,7 +149,15 @@ func (c *DatabaseConfig) GetDSN() string {
 func NewConfig() *Config {
 	return &Config{
 		Server: ServerConfig{
-			Port: "8080",
+			Port:            "8080",
+			ShutdownDrain:   getEnvDuration("SERVER_SHUTDOWN_DRAIN", 5*time.Second),
+			ShutdownTimeout: getEnvDuration("SERVER_SHUTDOWN_TIMEOUT", 5*time.Second),
+			TrustedProxies:  getEnvList("HTTP_TRUSTED_PROXIES", nil),
+		},
+		GRPC: GRPCConfig{
+			Enabled:    getEnv("GRPC_ENABLED", "true") == "true",
+			Port:       getEnv("GRPC_PORT", "9090"),
+			Reflection: getEnv("GRPC_REFLECTION", "true") == "true",
 		},
 		Database: DatabaseConfig{
 			Driver:   "mysql",
@@ -39,6 +166,176 @@ func NewConfig() *Config {
 			User:     "root",
 			Password: "",
 			DBName:   "melivendas",
+
+			ReadReplicas:         getEnvList("DB_READ_REPLICAS", nil),
+			ReplicaCheckInterval: getEnvDuration("DB_REPLICA_CHECK_INTERVAL", 5*time.Second),
+			ReadYourWritesWindow: getEnvDuration("DB_READ_YOUR_WRITES_WINDOW", 5*time.Second),
+
+			LegacySellerID: getEnv("DB_LEGACY_SELLER_ID", getEnv("AUTH_DEFAULT_SELLER_ID", "default")),
+		},
+		Auth: AuthConfig{
+			Enabled:         getEnv("AUTH_ENABLED", "true") == "true",
+			HMACSecret:      getEnv("AUTH_JWT_SECRET", ""),
+			JWKSFile:        getEnv("AUTH_JWKS_FILE", ""),
+			Issuer:          getEnv("AUTH_JWT_ISSUER", ""),
+			Audience:        getEnv("AUTH_JWT_AUDIENCE", ""),
+			PublicRoutes:    getEnvList("AUTH_PUBLIC_ROUTES", []string{"GET /metrics", "GET /healthz", "GET /readyz", "GET /openapi.json", "GET /docs"}),
+			DefaultSellerID: getEnv("AUTH_DEFAULT_SELLER_ID", "default"),
+		},
+		RateLimit: RateLimitConfig{
+			Enabled:    getEnv("RATE_LIMIT_ENABLED", "true") == "true",
+			Store:      getEnv("RATE_LIMIT_STORE", "memory"),
+			RedisAddr:  getEnv("RATE_LIMIT_REDIS_ADDR", "localhost:6379"),
+			ReadRate:   getEnvFloat("RATE_LIMIT_READ_RATE", 20),
+			ReadBurst:  getEnvInt("RATE_LIMIT_READ_BURST", 40),
+			WriteRate:  getEnvFloat("RATE_LIMIT_WRITE_RATE", 5),
+			WriteBurst: getEnvInt("RATE_LIMIT_WRITE_BURST", 10),
+			IPRate:     getEnvFloat("RATE_LIMIT_IP_RATE", 50),
+			IPBurst:    getEnvInt("RATE_LIMIT_IP_BURST", 100),
+		},
+		Tracing: TracingConfig{
+			ServiceName:  getEnv("OTEL_SERVICE_NAME", "melivendas-api"),
+			Exporter:     getEnv("TRACING_EXPORTER", "none"),
+			OTLPEndpoint: getEnv("TRACING_OTLP_ENDPOINT", "localhost:4318"),
+			OTLPInsecure: getEnv("TRACING_OTLP_INSECURE", "true") == "true",
+			SampleRatio:  getEnvFloat("TRACING_SAMPLE_RATIO", 1),
+		},
+		Log: LogConfig{
+			Level:  getEnv("LOG_LEVEL", "info"),
+			Format: getEnv("LOG_FORMAT", "json"),
+		},
+		Outbox: OutboxConfig{
+			Enabled:      getEnv("OUTBOX_RELAY_ENABLED", "true") == "true",
+			PollInterval: getEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:    getEnvInt("OUTBOX_BATCH_SIZE", 100),
+			Lease:        getEnvDuration("OUTBOX_LEASE", 30*time.Second),
 		},
+		Prices: PriceSchedulerConfig{
+			Enabled:      getEnv("PRICE_SCHEDULER_ENABLED", "true") == "true",
+			PollInterval: getEnvDuration("PRICE_SCHEDULER_POLL_INTERVAL", 10*time.Second),
+			BatchSize:    getEnvInt("PRICE_SCHEDULER_BATCH_SIZE", 100),
+			Lease:        getEnvDuration("PRICE_SCHEDULER_LEASE", 30*time.Second),
+		},
+		Webhooks: WebhookConfig{
+			Enabled:      getEnv("WEBHOOKS_ENABLED", "true") == "true",
+			PollInterval: getEnvDuration("WEBHOOKS_POLL_INTERVAL", time.Second),
+			BatchSize:    getEnvInt("WEBHOOKS_BATCH_SIZE", 50),
+			Lease:        getEnvDuration("WEBHOOKS_LEASE", time.Minute),
+			Timeout:      getEnvDuration("WEBHOOKS_TIMEOUT", 10*time.Second),
+			MaxAttempts:  getEnvInt("WEBHOOKS_MAX_ATTEMPTS", 8),
+			BaseBackoff:  getEnvDuration("WEBHOOKS_BASE_BACKOFF", 30*time.Second),
+			DisableAfter: getEnvInt("WEBHOOKS_DISABLE_AFTER", 20),
+
+			AllowInternalTargets: getEnv("WEBHOOKS_ALLOW_INTERNAL_TARGETS", "false") == "true",
+		},
+		Stream: StreamConfig{
+			ReplayBuffer: getEnvInt("STREAM_REPLAY_BUFFER", 1000),
+			Heartbeat:    getEnvDuration("STREAM_HEARTBEAT", 15*time.Second),
+		},
+		OpenAPI: OpenAPIConfig{
+			ValidateRequests:  getEnv("OPENAPI_VALIDATE_REQUESTS", "true") == "true",
+			ValidateResponses: getEnv("OPENAPI_VALIDATE_RESPONSES", "false") == "true",
+		},
+		Errors: ErrorsConfig{
+			ProblemJSON: getEnv("ERRORS_PROBLEM_JSON", "false") == "true",
+		},
+		Cache: CacheConfig{
+			Enabled: getEnv("ITEM_CACHE_ENABLED", "true") == "true",
+			Size:    getEnvInt("ITEM_CACHE_SIZE", 10000),
+			TTL:     getEnvDuration("ITEM_CACHE_TTL", 30*time.Second),
+		},
+		HTTPCache: HTTPCacheConfig{
+			CacheControl: getEnv("HTTP_CACHE_CONTROL", "private, no-cache"),
+		},
+	}
+}
+
+func (c *Config) Validate() error {
+	if c.Auth.Enabled && c.Auth.HMACSecret == "" && c.Auth.JWKSFile == "" {
+		return errors.New("AUTH_ENABLED is true but neither AUTH_JWT_SECRET nor AUTH_JWKS_FILE is set; configure one of them, or set AUTH_ENABLED=false for local development")
+	}
+
+	if c.RateLimit.Enabled {
+		limits := []struct {
+			name  string
+			rate  float64
+			burst int
+		}{
+			{"READ", c.RateLimit.ReadRate, c.RateLimit.ReadBurst},
+			{"WRITE", c.RateLimit.WriteRate, c.RateLimit.WriteBurst},
+			{"IP", c.RateLimit.IPRate, c.RateLimit.IPBurst},
+		}
+		for _, limit := range limits {
+			if limit.rate <= 0 {
+				return fmt.Errorf("RATE_LIMIT_%s_RATE must be greater than 0, got %v", limit.name, limit.rate)
+			}
+			if limit.burst <= 0 {
+				return fmt.Errorf("RATE_LIMIT_%s_BURST must be greater than 0, got %d", limit.name, limit.burst)
+			}
+		}
+	}
+
+	return nil
+}
+
+func getEnv(key, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok {
+		return value
+	}
+	return fallback
+}
+
+func getEnvList(key string, fallback []string) []string {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return fallback
+	}
+
+	var list []string
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry != "" {
+			list = append(list, entry)
+		}
+	}
+	return list
+}
+
+func getEnvInt(key string, fallback int) int {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return fallback
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return fallback
+	}
+	return parsed
+}
+
+func getEnvFloat(key string, fallback float64) float64 {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return fallback
+	}
+
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fallback
+	}
+	return parsed
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return fallback
+	}
+
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return fallback
 	}
+	return parsed
 }
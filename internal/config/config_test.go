@@ -0,0 +1,65 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		wantErr []string
+	}{
+		{
+			name:    "ambiente vazio exige segredo ou JWKS",
+			env:     map[string]string{},
+			wantErr: []string{"AUTH_JWT_SECRET", "AUTH_JWKS_FILE", "AUTH_ENABLED=false"},
+		},
+		{name: "autenticação desligada", env: map[string]string{"AUTH_ENABLED": "false"}},
+		{name: "segredo HMAC", env: map[string]string{"AUTH_JWT_SECRET": "segredo"}},
+		{name: "arquivo JWKS", env: map[string]string{"AUTH_JWKS_FILE": "/etc/jwks.json"}},
+		{
+			name:    "taxa de leitura zero",
+			env:     map[string]string{"AUTH_ENABLED": "false", "RATE_LIMIT_READ_RATE": "0"},
+			wantErr: []string{"RATE_LIMIT_READ_RATE"},
+		},
+		{
+			name:    "rajada por IP negativa",
+			env:     map[string]string{"AUTH_ENABLED": "false", "RATE_LIMIT_IP_BURST": "-1"},
+			wantErr: []string{"RATE_LIMIT_IP_BURST"},
+		},
+		{
+			name: "limites ignorados com o limitador desligado",
+			env:  map[string]string{"AUTH_ENABLED": "false", "RATE_LIMIT_ENABLED": "false", "RATE_LIMIT_WRITE_RATE": "0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := map[string]string{"AUTH_ENABLED": "true", "AUTH_JWT_SECRET": "", "AUTH_JWKS_FILE": ""}
+			for key, value := range tt.env {
+				env[key] = value
+			}
+			for key, value := range env {
+				t.Setenv(key, value)
+			}
+
+			err := NewConfig().Validate()
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Fatalf("erro inesperado: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("esperava erro de configuração")
+			}
+			for _, fragment := range tt.wantErr {
+				if !strings.Contains(err.Error(), fragment) {
+					t.Errorf("mensagem %q não menciona %s", err.Error(), fragment)
+				}
+			}
+		})
+	}
+}
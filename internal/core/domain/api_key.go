@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"time"
+)
+
+type APIKey struct {
+	ID          int64      `json:"id"`
+	SellerID    string     `json:"seller_id"`
+	Name        string     `json:"name"`
+	Prefix      string     `json:"prefix"`
+	SecretHash  string     `json:"-"`
+	Scopes      []string   `json:"scopes"`
+	CreatedBy   string     `json:"created_by"`
+	RotatedFrom *int64     `json:"rotated_from,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+}
+
+func NewAPIKey(name, prefix, secretHash string, scopes []string, createdBy string, expiresAt *time.Time) *APIKey {
+	return &APIKey{
+		Name:       name,
+		Prefix:     prefix,
+		SecretHash: secretHash,
+		Scopes:     scopes,
+		CreatedBy:  createdBy,
+		CreatedAt:  time.Now(),
+		ExpiresAt:  expiresAt,
+	}
+}
+
+func (k *APIKey) IsActive(now time.Time) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	if k.ExpiresAt != nil && !now.Before(*k.ExpiresAt) {
+		return false
+	}
+	return true
+}
+
+func (k *APIKey) Principal() *Principal {
+	return &Principal{
+		Subject:  "api-key:" + k.Prefix,
+		Name:     k.Name,
+		SellerID: k.SellerID,
+		Scopes:   k.Scopes,
+	}
+}
+
+type CreatedAPIKey struct {
+	APIKey *APIKey `json:"api_key"`
+	Secret string  `json:"secret"`
+}
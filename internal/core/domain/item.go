@@ -1,9 +1,12 @@
 package domain
 
 import (
+	"errors"
 	"time"
 )
 
+var ErrDuplicateItemCode = errors.New("um item com este código já existe")
+
 type ItemStatus string
 
 const (
@@ -14,17 +17,21 @@ const (
 
 type Item struct {
 	ID          int64      `json:"id" db:"id"`
+	SellerID    string     `json:"seller_id" db:"seller_id"`
 	Code        string     `json:"code" db:"code"`
 	Title       string     `json:"title" db:"title"`
 	Description string     `json:"description" db:"description"`
+	Category    string     `json:"category" db:"category"`
 	Price       int64      `json:"price" db:"price"`
 	Stock       int64      `json:"stock" db:"stock"`
 	Status      ItemStatus `json:"status" db:"status"`
 	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at" db:"updated_at"`
+
+	events []ItemEvent
 }
 
-func NewItem(code, title, description string, price, stock int64) *Item {
+func NewItem(code, title, description, category string, price, stock int64) *Item {
 	status := ItemStatusActive
 	if stock == 0 {
 		status = ItemStatusInactive
@@ -32,19 +39,26 @@ func NewItem(code, title, description string, price, stock int64) *Item {
 
 	now := time.Now()
 
-	return &Item{
+	item := &Item{
 		Code:        code,
 		Title:       title,
 		Description: description,
+		Category:    category,
 		Price:       price,
 		Stock:       stock,
 		Status:      status,
 		CreatedAt:   now,
 		UpdatedAt:   now,
 	}
+	item.record(newItemEvent(EventItemCreated))
+
+	return item
 }
 
 func (i *Item) UpdateStock(stock int64) {
+	previousStock := i.Stock
+	previousStatus := i.Status
+
 	i.Stock = stock
 	if stock == 0 {
 		i.Status = ItemStatusInactive
@@ -52,17 +66,108 @@ func (i *Item) UpdateStock(stock int64) {
 		i.Status = ItemStatusActive
 	}
 	i.UpdatedAt = time.Now()
+
+	if previousStock != i.Stock {
+		event := newItemEvent(EventStockChanged)
+		event.PreviousStock = &previousStock
+		i.record(event)
+	}
+
+	if previousStatus != i.Status {
+		event := newItemEvent(EventItemStatusChanged)
+		event.PreviousStatus = previousStatus
+		i.record(event)
+	}
 }
 
-func (i *Item) UpdateItem(code, title, description string, price, stock int64) {
+func (i *Item) UpdateItem(code, title, description, category string, price, stock int64) {
+	previousPrice := i.Price
+
+	var changed []string
+	if i.Code != code {
+		changed = append(changed, "code")
+	}
+	if i.Title != title {
+		changed = append(changed, "title")
+	}
+	if i.Description != description {
+		changed = append(changed, "description")
+	}
+	if i.Category != category {
+		changed = append(changed, "category")
+	}
+	if i.Price != price {
+		changed = append(changed, "price")
+	}
+
 	i.Code = code
 	i.Title = title
 	i.Description = description
+	i.Category = category
 	i.Price = price
+
+	if len(changed) > 0 {
+		event := newItemEvent(EventItemUpdated)
+		event.ChangedFields = changed
+		if previousPrice != price {
+			event.PreviousPrice = &previousPrice
+			event.PriceSource = PriceChangeManual
+		}
+		i.record(event)
+	}
+
 	i.UpdateStock(stock)
 }
 
+func (i *Item) ChangePrice(price int64, source PriceChangeSource, scheduledPriceID *int64) {
+	if i.Price == price {
+		return
+	}
+
+	previousPrice := i.Price
+
+	i.Price = price
+	i.UpdatedAt = time.Now()
+
+	event := newItemEvent(EventItemUpdated)
+	event.ChangedFields = []string{"price"}
+	event.PreviousPrice = &previousPrice
+	event.PriceSource = source
+	event.ScheduledPriceID = scheduledPriceID
+	i.record(event)
+}
+
+func (i *Item) MarkDeleted() {
+	i.record(newItemEvent(EventItemDeleted))
+}
+
+func (i *Item) record(event ItemEvent) {
+	i.events = append(i.events, event)
+}
+
+func (i *Item) PullEvents() []ItemEvent {
+	events := i.events
+	i.events = nil
+
+	snapshot := *i
+	snapshot.events = nil
+
+	for idx := range events {
+		events[idx].ItemID = i.ID
+		events[idx].SellerID = i.SellerID
+		events[idx].Item = snapshot
+	}
+
+	return events
+}
+
 type PagedItems struct {
 	TotalPaginas int    `json:"totalPaginas"`
 	Dados        []Item `json:"dados"`
 }
+
+type ItemStatusStats struct {
+	Status     ItemStatus `json:"status" db:"status"`
+	Count      int64      `json:"count" db:"count"`
+	TotalStock int64      `json:"total_stock" db:"total_stock"`
+}
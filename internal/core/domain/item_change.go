@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"time"
+)
+
+type ItemChange struct {
+	Sequence   int64     `json:"sequence"`
+	Type       EventType `json:"type"`
+	SellerID   string    `json:"-"`
+	Item       Item      `json:"item"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+func NewItemChange(eventType EventType, item *Item) ItemChange {
+	return ItemChange{
+		Type:       eventType,
+		SellerID:   item.SellerID,
+		Item:       *item,
+		OccurredAt: time.Now(),
+	}
+}
+
+type ItemChangeFilter struct {
+	ItemIDs []int64
+	Status  ItemStatus
+}
+
+func (f ItemChangeFilter) Matches(change ItemChange) bool {
+	if f.Status != "" && change.Item.Status != f.Status {
+		return false
+	}
+
+	if len(f.ItemIDs) == 0 {
+		return true
+	}
+
+	for _, id := range f.ItemIDs {
+		if id == change.Item.ID {
+			return true
+		}
+	}
+	return false
+}
+
+type ItemChangeSubscription struct {
+	Replay    []ItemChange
+	Truncated bool
+	Changes   <-chan ItemChange
+	Close     func()
+}
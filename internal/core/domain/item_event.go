@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
+type EventType string
+
+const (
+	EventItemCreated       EventType = "ItemCreated"
+	EventItemUpdated       EventType = "ItemUpdated"
+	EventStockChanged      EventType = "StockChanged"
+	EventItemStatusChanged EventType = "ItemStatusChanged"
+	EventItemDeleted       EventType = "ItemDeleted"
+)
+
+func (t EventType) IsValid() bool {
+	switch t {
+	case EventItemCreated, EventItemUpdated, EventStockChanged, EventItemStatusChanged, EventItemDeleted:
+		return true
+	default:
+		return false
+	}
+}
+
+type ItemEvent struct {
+	ID               string            `json:"id"`
+	Type             EventType         `json:"type"`
+	OccurredAt       time.Time         `json:"occurred_at"`
+	ItemID           int64             `json:"item_id"`
+	SellerID         string            `json:"seller_id"`
+	Item             Item              `json:"item"`
+	ChangedFields    []string          `json:"changed_fields,omitempty"`
+	PreviousPrice    *int64            `json:"previous_price,omitempty"`
+	PreviousStock    *int64            `json:"previous_stock,omitempty"`
+	PreviousStatus   ItemStatus        `json:"previous_status,omitempty"`
+	PriceSource      PriceChangeSource `json:"price_source,omitempty"`
+	ScheduledPriceID *int64            `json:"scheduled_price_id,omitempty"`
+}
+
+type OutboxEntry struct {
+	ID       int64
+	Attempts int
+	Event    ItemEvent
+}
+
+func newItemEvent(eventType EventType) ItemEvent {
+	return ItemEvent{
+		ID:         newEventID(),
+		Type:       eventType,
+		OccurredAt: time.Now(),
+	}
+}
+
+func newEventID() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return time.Now().Format("20060102150405.000000000")
+	}
+	return hex.EncodeToString(buf)
+}
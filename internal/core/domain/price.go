@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"time"
+)
+
+type PriceChangeSource string
+
+const (
+	PriceChangeManual        PriceChangeSource = "MANUAL"
+	PriceChangeScheduled     PriceChangeSource = "SCHEDULED"
+	PriceChangeScheduleEnded PriceChangeSource = "SCHEDULE_ENDED"
+)
+
+type PriceHistoryEntry struct {
+	ID               int64             `json:"id"`
+	ItemID           int64             `json:"item_id"`
+	PreviousPrice    *int64            `json:"previous_price,omitempty"`
+	Price            int64             `json:"price"`
+	Source           PriceChangeSource `json:"source"`
+	ScheduledPriceID *int64            `json:"scheduled_price_id,omitempty"`
+	ChangedAt        time.Time         `json:"changed_at"`
+}
+
+type ScheduledPriceStatus string
+
+const (
+	ScheduledPricePending   ScheduledPriceStatus = "PENDING"
+	ScheduledPriceActive    ScheduledPriceStatus = "ACTIVE"
+	ScheduledPriceCompleted ScheduledPriceStatus = "COMPLETED"
+	ScheduledPriceCancelled ScheduledPriceStatus = "CANCELLED"
+)
+
+type ScheduledPrice struct {
+	ID            int64                `json:"id"`
+	ItemID        int64                `json:"item_id"`
+	SellerID      string               `json:"seller_id"`
+	Price         int64                `json:"price"`
+	StartsAt      time.Time            `json:"starts_at"`
+	EndsAt        *time.Time           `json:"ends_at,omitempty"`
+	PreviousPrice *int64               `json:"previous_price,omitempty"`
+	Status        ScheduledPriceStatus `json:"status"`
+	CreatedAt     time.Time            `json:"created_at"`
+	UpdatedAt     time.Time            `json:"updated_at"`
+}
+
+func NewScheduledPrice(itemID, price int64, startsAt time.Time, endsAt *time.Time) *ScheduledPrice {
+	now := time.Now()
+
+	return &ScheduledPrice{
+		ItemID:    itemID,
+		Price:     price,
+		StartsAt:  startsAt,
+		EndsAt:    endsAt,
+		Status:    ScheduledPricePending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func (s *ScheduledPrice) IsOpen() bool {
+	return s.Status == ScheduledPricePending || s.Status == ScheduledPriceActive
+}
+
+func (s *ScheduledPrice) Overlaps(startsAt time.Time, endsAt *time.Time) bool {
+	if s.EndsAt != nil && !s.EndsAt.After(startsAt) {
+		return false
+	}
+	if endsAt != nil && !endsAt.After(s.StartsAt) {
+		return false
+	}
+	return true
+}
+
+func (s *ScheduledPrice) Expired(now time.Time) bool {
+	return s.EndsAt != nil && !s.EndsAt.After(now)
+}
+
+func (s *ScheduledPrice) Activate(previousPrice int64) {
+	s.PreviousPrice = &previousPrice
+	s.Status = ScheduledPriceActive
+	s.UpdatedAt = time.Now()
+}
+
+func (s *ScheduledPrice) Complete() {
+	s.Status = ScheduledPriceCompleted
+	s.UpdatedAt = time.Now()
+}
+
+func (s *ScheduledPrice) Cancel() {
+	s.Status = ScheduledPriceCancelled
+	s.UpdatedAt = time.Now()
+}
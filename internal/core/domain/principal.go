@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrMissingSeller = errors.New("vendedor ausente no contexto da requisição")
+
+type Principal struct {
+	Subject  string   `json:"sub"`
+	Name     string   `json:"name,omitempty"`
+	SellerID string   `json:"seller_id,omitempty"`
+	Roles    []string `json:"roles,omitempty"`
+	Scopes   []string `json:"scopes,omitempty"`
+}
+
+type principalContextKey struct{}
+
+func ContextWithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
+
+func SellerIDFromContext(ctx context.Context) (string, bool) {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok || principal.SellerID == "" {
+		return "", false
+	}
+	return principal.SellerID, true
+}
+
+const (
+	RoleViewer            = "viewer"
+	RoleEditor            = "editor"
+	RoleInventoryOperator = "inventory-operator"
+	RoleAdmin             = "admin"
+)
+
+type Action string
+
+const (
+	ActionItemRead        Action = "item:read"
+	ActionItemCreate      Action = "item:create"
+	ActionItemUpdate      Action = "item:update"
+	ActionItemChangeStock Action = "item:change-stock"
+	ActionItemDelete      Action = "item:delete"
+	ActionAPIKeyManage    Action = "api-key:manage"
+	ActionWebhookManage   Action = "webhook:manage"
+	ActionPromotionManage Action = "promotion:manage"
+)
+
+func (a Action) IsValid() bool {
+	switch a {
+	case ActionItemRead, ActionItemCreate, ActionItemUpdate, ActionItemChangeStock, ActionItemDelete, ActionAPIKeyManage, ActionWebhookManage, ActionPromotionManage:
+		return true
+	default:
+		return false
+	}
+}
+
+func (p *Principal) HasRole(role string) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Principal) HasScope(scope string) bool {
+	for _, s := range p.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
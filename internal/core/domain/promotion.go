@@ -0,0 +1,175 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+type DiscountType string
+
+const (
+	DiscountPercentage DiscountType = "PERCENTAGE"
+	DiscountFixed      DiscountType = "FIXED"
+)
+
+func (t DiscountType) IsValid() bool {
+	return t == DiscountPercentage || t == DiscountFixed
+}
+
+type Promotion struct {
+	ID           int64        `json:"id"`
+	SellerID     string       `json:"seller_id"`
+	Name         string       `json:"name"`
+	DiscountType DiscountType `json:"discount_type"`
+	Value        int64        `json:"value"`
+	ItemIDs      []int64      `json:"item_ids"`
+	Categories   []string     `json:"categories"`
+	Codes        []string     `json:"codes"`
+	Priority     int          `json:"priority"`
+	Stackable    bool         `json:"stackable"`
+	StartsAt     time.Time    `json:"starts_at"`
+	EndsAt       *time.Time   `json:"ends_at,omitempty"`
+	Active       bool         `json:"active"`
+	CreatedAt    time.Time    `json:"created_at"`
+	UpdatedAt    time.Time    `json:"updated_at"`
+}
+
+func NewPromotion(name string, discountType DiscountType, value int64, itemIDs []int64, categories, codes []string, priority int, stackable bool, startsAt time.Time, endsAt *time.Time) *Promotion {
+	now := time.Now()
+
+	if startsAt.IsZero() {
+		startsAt = now
+	}
+	if itemIDs == nil {
+		itemIDs = []int64{}
+	}
+	if categories == nil {
+		categories = []string{}
+	}
+	if codes == nil {
+		codes = []string{}
+	}
+
+	return &Promotion{
+		Name:         name,
+		DiscountType: discountType,
+		Value:        value,
+		ItemIDs:      itemIDs,
+		Categories:   categories,
+		Codes:        codes,
+		Priority:     priority,
+		Stackable:    stackable,
+		StartsAt:     startsAt,
+		EndsAt:       endsAt,
+		Active:       true,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+}
+
+func (p *Promotion) Deactivate() {
+	p.Active = false
+	p.UpdatedAt = time.Now()
+}
+
+func (p *Promotion) InEffect(now time.Time) bool {
+	if !p.Active || p.StartsAt.After(now) {
+		return false
+	}
+	return p.EndsAt == nil || p.EndsAt.After(now)
+}
+
+func (p *Promotion) Targets(item *Item) bool {
+	for _, id := range p.ItemIDs {
+		if id == item.ID {
+			return true
+		}
+	}
+	for _, code := range p.Codes {
+		if code == item.Code {
+			return true
+		}
+	}
+	if item.Category != "" {
+		for _, category := range p.Categories {
+			if category == item.Category {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (p *Promotion) Discount(price int64) int64 {
+	var discount int64
+	switch p.DiscountType {
+	case DiscountPercentage:
+		discount = price * p.Value / 100
+	case DiscountFixed:
+		discount = p.Value
+	}
+
+	if discount > price {
+		return price
+	}
+	return discount
+}
+
+type AppliedPromotion struct {
+	ID           int64        `json:"id"`
+	Name         string       `json:"name"`
+	DiscountType DiscountType `json:"discount_type"`
+	Value        int64        `json:"value"`
+	Discount     int64        `json:"discount"`
+}
+
+type PricedItem struct {
+	Item
+	OriginalPrice     int64              `json:"original_price"`
+	EffectivePrice    int64              `json:"effective_price"`
+	AppliedPromotions []AppliedPromotion `json:"applied_promotions"`
+
+	PricingChangedAt time.Time `json:"-"`
+}
+
+func PriceItem(item Item, promotions []*Promotion, now time.Time) PricedItem {
+	priced := PricedItem{
+		Item:              item,
+		OriginalPrice:     item.Price,
+		EffectivePrice:    item.Price,
+		AppliedPromotions: []AppliedPromotion{},
+		PricingChangedAt:  item.UpdatedAt,
+	}
+
+	var candidates []*Promotion
+	for _, promotion := range promotions {
+		if promotion.Targets(&item) && promotion.InEffect(now) {
+			candidates = append(candidates, promotion)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Priority != candidates[j].Priority {
+			return candidates[i].Priority > candidates[j].Priority
+		}
+		return candidates[i].ID < candidates[j].ID
+	})
+
+	for i, promotion := range candidates {
+		if i > 0 && (!promotion.Stackable || !candidates[0].Stackable) {
+			continue
+		}
+
+		discount := promotion.Discount(priced.EffectivePrice)
+		priced.EffectivePrice -= discount
+		priced.AppliedPromotions = append(priced.AppliedPromotions, AppliedPromotion{
+			ID:           promotion.ID,
+			Name:         promotion.Name,
+			DiscountType: promotion.DiscountType,
+			Value:        promotion.Value,
+			Discount:     discount,
+		})
+	}
+
+	return priced
+}
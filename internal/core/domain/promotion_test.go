@@ -0,0 +1,170 @@
+package domain_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+)
+
+func TestPriceItem(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	yesterday := now.Add(-24 * time.Hour)
+	tomorrow := now.Add(24 * time.Hour)
+
+	item := domain.Item{ID: 7, Code: "CAM-01", Category: "moda", Price: 10000}
+
+	promotion := func(id int64, discountType domain.DiscountType, value int64, priority int, stackable bool) *domain.Promotion {
+		return &domain.Promotion{
+			ID:           id,
+			DiscountType: discountType,
+			Value:        value,
+			ItemIDs:      []int64{item.ID},
+			Priority:     priority,
+			Stackable:    stackable,
+			StartsAt:     yesterday,
+			Active:       true,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		promotions []*domain.Promotion
+		wantPrice  int64
+		wantIDs    []int64
+	}{
+		{
+			name:      "sem promoções",
+			wantPrice: 10000,
+			wantIDs:   []int64{},
+		},
+		{
+			name:       "desconto percentual",
+			promotions: []*domain.Promotion{promotion(1, domain.DiscountPercentage, 15, 0, false)},
+			wantPrice:  8500,
+			wantIDs:    []int64{1},
+		},
+		{
+			name:       "desconto fixo",
+			promotions: []*domain.Promotion{promotion(1, domain.DiscountFixed, 2500, 0, false)},
+			wantPrice:  7500,
+			wantIDs:    []int64{1},
+		},
+		{
+			name: "maior prioridade vence quando não acumulável",
+			promotions: []*domain.Promotion{
+				promotion(1, domain.DiscountPercentage, 50, 1, false),
+				promotion(2, domain.DiscountFixed, 1000, 5, false),
+			},
+			wantPrice: 9000,
+			wantIDs:   []int64{2},
+		},
+		{
+			name: "empate de prioridade usa o menor id",
+			promotions: []*domain.Promotion{
+				promotion(3, domain.DiscountFixed, 3000, 2, false),
+				promotion(2, domain.DiscountFixed, 1000, 2, false),
+			},
+			wantPrice: 9000,
+			wantIDs:   []int64{2},
+		},
+		{
+			name: "acumuláveis aplicam em sequência sobre o preço já descontado",
+			promotions: []*domain.Promotion{
+				promotion(1, domain.DiscountPercentage, 10, 1, true),
+				promotion(2, domain.DiscountFixed, 1000, 5, true),
+			},
+			wantPrice: 8100,
+			wantIDs:   []int64{2, 1},
+		},
+		{
+			name: "principal não acumulável bloqueia as demais",
+			promotions: []*domain.Promotion{
+				promotion(1, domain.DiscountPercentage, 10, 1, true),
+				promotion(2, domain.DiscountFixed, 1000, 5, false),
+			},
+			wantPrice: 9000,
+			wantIDs:   []int64{2},
+		},
+		{
+			name: "não acumulável secundária é ignorada",
+			promotions: []*domain.Promotion{
+				promotion(1, domain.DiscountPercentage, 10, 1, false),
+				promotion(2, domain.DiscountFixed, 1000, 5, true),
+				promotion(3, domain.DiscountFixed, 500, 0, true),
+			},
+			wantPrice: 8500,
+			wantIDs:   []int64{2, 3},
+		},
+		{
+			name:       "desconto fixo maior que o preço zera o valor",
+			promotions: []*domain.Promotion{promotion(1, domain.DiscountFixed, 15000, 0, false)},
+			wantPrice:  0,
+			wantIDs:    []int64{1},
+		},
+		{
+			name: "acúmulo nunca deixa o preço negativo",
+			promotions: []*domain.Promotion{
+				promotion(1, domain.DiscountPercentage, 100, 5, true),
+				promotion(2, domain.DiscountFixed, 500, 1, true),
+			},
+			wantPrice: 0,
+			wantIDs:   []int64{1, 2},
+		},
+		{
+			name: "promoções fora da janela ou inativas não se aplicam",
+			promotions: func() []*domain.Promotion {
+				future := promotion(1, domain.DiscountFixed, 1000, 0, true)
+				future.StartsAt = tomorrow
+				expired := promotion(2, domain.DiscountFixed, 1000, 0, true)
+				expired.EndsAt = &yesterday
+				inactive := promotion(3, domain.DiscountFixed, 1000, 0, true)
+				inactive.Active = false
+				return []*domain.Promotion{future, expired, inactive}
+			}(),
+			wantPrice: 10000,
+			wantIDs:   []int64{},
+		},
+		{
+			name: "alvo por categoria e código",
+			promotions: func() []*domain.Promotion {
+				byCategory := promotion(1, domain.DiscountFixed, 1000, 1, true)
+				byCategory.ItemIDs, byCategory.Categories = nil, []string{"moda"}
+				byCode := promotion(2, domain.DiscountFixed, 500, 0, true)
+				byCode.ItemIDs, byCode.Codes = nil, []string{"CAM-01"}
+				other := promotion(3, domain.DiscountFixed, 500, 0, true)
+				other.ItemIDs, other.Categories = nil, []string{"casa"}
+				return []*domain.Promotion{byCategory, byCode, other}
+			}(),
+			wantPrice: 8500,
+			wantIDs:   []int64{1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			priced := domain.PriceItem(item, tt.promotions, now)
+
+			if priced.OriginalPrice != item.Price {
+				t.Errorf("preço original = %d, esperado %d", priced.OriginalPrice, item.Price)
+			}
+			if priced.EffectivePrice != tt.wantPrice {
+				t.Errorf("preço efetivo = %d, esperado %d", priced.EffectivePrice, tt.wantPrice)
+			}
+
+			ids := []int64{}
+			var discounts int64
+			for _, applied := range priced.AppliedPromotions {
+				ids = append(ids, applied.ID)
+				discounts += applied.Discount
+			}
+			if !reflect.DeepEqual(ids, tt.wantIDs) {
+				t.Errorf("promoções aplicadas = %v, esperado %v", ids, tt.wantIDs)
+			}
+			if item.Price-discounts != priced.EffectivePrice {
+				t.Errorf("descontos somam %d, mas o preço efetivo é %d", discounts, priced.EffectivePrice)
+			}
+		})
+	}
+}
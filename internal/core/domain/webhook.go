@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"net"
+	"time"
+)
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "FAILED"
+)
+
+var carrierGradeNAT = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+func IsInternalAddress(ip net.IP) bool {
+	return ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() ||
+		ip.IsUnspecified() ||
+		carrierGradeNAT.Contains(ip)
+}
+
+type WebhookSubscription struct {
+	ID                  int64      `json:"id"`
+	SellerID            string     `json:"seller_id"`
+	URL                 string     `json:"url"`
+	EventTypes          []string   `json:"event_types"`
+	Secret              string     `json:"-"`
+	Active              bool       `json:"active"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	DisabledAt          *time.Time `json:"disabled_at,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+}
+
+func NewWebhookSubscription(url string, eventTypes []string, secret string) *WebhookSubscription {
+	now := time.Now()
+
+	return &WebhookSubscription{
+		URL:        url,
+		EventTypes: eventTypes,
+		Secret:     secret,
+		Active:     true,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
+func (s *WebhookSubscription) Accepts(eventType EventType) bool {
+	for _, t := range s.EventTypes {
+		if t == "*" || t == string(eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *WebhookSubscription) RecordSuccess() {
+	s.ConsecutiveFailures = 0
+	s.UpdatedAt = time.Now()
+}
+
+func (s *WebhookSubscription) RecordFailure(disableAfter int) {
+	s.ConsecutiveFailures++
+	s.UpdatedAt = time.Now()
+
+	if disableAfter > 0 && s.ConsecutiveFailures >= disableAfter && s.Active {
+		s.Active = false
+		disabledAt := s.UpdatedAt
+		s.DisabledAt = &disabledAt
+	}
+}
+
+func (s *WebhookSubscription) Enable() {
+	s.Active = true
+	s.ConsecutiveFailures = 0
+	s.DisabledAt = nil
+	s.UpdatedAt = time.Now()
+}
+
+type WebhookDelivery struct {
+	ID             int64                 `json:"id"`
+	SubscriptionID int64                 `json:"subscription_id"`
+	EventID        string                `json:"event_id"`
+	EventType      EventType             `json:"event_type"`
+	RedeliveryOf   *int64                `json:"redelivery_of,omitempty"`
+	Payload        []byte                `json:"-"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int                   `json:"attempts"`
+	ResponseCode   *int                  `json:"response_code,omitempty"`
+	LastError      string                `json:"last_error,omitempty"`
+	NextAttemptAt  time.Time             `json:"next_attempt_at"`
+	CreatedAt      time.Time             `json:"created_at"`
+	DeliveredAt    *time.Time            `json:"delivered_at,omitempty"`
+}
+
+func NewWebhookDelivery(subscriptionID int64, eventID string, eventType EventType, payload []byte) *WebhookDelivery {
+	now := time.Now()
+
+	return &WebhookDelivery{
+		SubscriptionID: subscriptionID,
+		EventID:        eventID,
+		EventType:      eventType,
+		Payload:        payload,
+		Status:         WebhookDeliveryPending,
+		NextAttemptAt:  now,
+		CreatedAt:      now,
+	}
+}
+
+func (d *WebhookDelivery) Redelivery() *WebhookDelivery {
+	redelivery := NewWebhookDelivery(d.SubscriptionID, d.EventID, d.EventType, d.Payload)
+	originalID := d.ID
+	redelivery.RedeliveryOf = &originalID
+	return redelivery
+}
+
+type WebhookDeliveryJob struct {
+	Delivery     *WebhookDelivery
+	Subscription *WebhookSubscription
+}
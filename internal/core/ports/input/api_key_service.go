@@ -0,0 +1,20 @@
+package input
+
+import (
+	"context"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+)
+
+type APIKeyService interface {
+	CreateAPIKey(ctx context.Context, name string, scopes []string, expiresAt *time.Time) (*domain.CreatedAPIKey, error)
+
+	ListAPIKeys(ctx context.Context) ([]*domain.APIKey, error)
+
+	RevokeAPIKey(ctx context.Context, id int64) error
+
+	RotateAPIKey(ctx context.Context, id int64, overlap time.Duration) (*domain.CreatedAPIKey, error)
+
+	AuthenticateAPIKey(ctx context.Context, rawKey string) (*domain.Principal, error)
+}
@@ -7,13 +7,19 @@ import (
 )
 
 type ItemService interface {
-	CreateItem(ctx context.Context, code, title, description string, price, stock int64) (*domain.Item, error)
+	CreateItem(ctx context.Context, code, title, description, category string, price, stock int64) (*domain.Item, error)
 
 	GetItem(ctx context.Context, id int64) (*domain.Item, error)
 
-	UpdateItem(ctx context.Context, id int64, code, title, description string, price, stock int64) (*domain.Item, error)
+	UpdateItem(ctx context.Context, id int64, code, title, description string, category *string, price, stock int64) (*domain.Item, error)
 
 	DeleteItem(ctx context.Context, id int64) error
 
+	GetItemByCode(ctx context.Context, code string) (*domain.Item, error)
+
+	UpsertItemByCode(ctx context.Context, code, title, description string, category *string, price, stock int64) (*domain.Item, bool, error)
+
+	DeleteItemByCode(ctx context.Context, code string) error
+
 	ListItems(ctx context.Context, status string, limit, page int) (*domain.PagedItems, error)
 }
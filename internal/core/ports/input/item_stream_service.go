@@ -0,0 +1,11 @@
+package input
+
+import (
+	"context"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+)
+
+type ItemStreamService interface {
+	Subscribe(ctx context.Context, filter domain.ItemChangeFilter, lastSequence int64) (*domain.ItemChangeSubscription, error)
+}
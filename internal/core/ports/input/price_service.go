@@ -0,0 +1,18 @@
+package input
+
+import (
+	"context"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+)
+
+type PriceService interface {
+	ListPriceHistory(ctx context.Context, itemID int64, limit int) ([]*domain.PriceHistoryEntry, error)
+
+	SchedulePrice(ctx context.Context, itemID, price int64, startsAt time.Time, endsAt *time.Time) (*domain.ScheduledPrice, error)
+
+	ListScheduledPrices(ctx context.Context, itemID int64) ([]*domain.ScheduledPrice, error)
+
+	CancelScheduledPrice(ctx context.Context, itemID, id int64) (*domain.ScheduledPrice, error)
+}
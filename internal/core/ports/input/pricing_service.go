@@ -0,0 +1,13 @@
+package input
+
+import (
+	"context"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+)
+
+type PricingService interface {
+	PriceItem(ctx context.Context, item *domain.Item) (*domain.PricedItem, error)
+
+	PriceItems(ctx context.Context, items []domain.Item) ([]domain.PricedItem, error)
+}
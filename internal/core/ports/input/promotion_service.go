@@ -0,0 +1,18 @@
+package input
+
+import (
+	"context"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+)
+
+type PromotionService interface {
+	CreatePromotion(ctx context.Context, name string, discountType domain.DiscountType, value int64, itemIDs []int64, categories, codes []string, priority int, stackable bool, startsAt time.Time, endsAt *time.Time) (*domain.Promotion, error)
+
+	GetPromotion(ctx context.Context, id int64) (*domain.Promotion, error)
+
+	ListPromotions(ctx context.Context) ([]*domain.Promotion, error)
+
+	DeactivatePromotion(ctx context.Context, id int64) (*domain.Promotion, error)
+}
@@ -0,0 +1,21 @@
+package input
+
+import (
+	"context"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+)
+
+type WebhookService interface {
+	CreateSubscription(ctx context.Context, url string, eventTypes []string, secret string) (*domain.WebhookSubscription, string, error)
+
+	ListSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error)
+
+	DeleteSubscription(ctx context.Context, id int64) error
+
+	EnableSubscription(ctx context.Context, id int64) (*domain.WebhookSubscription, error)
+
+	ListDeliveries(ctx context.Context, subscriptionID int64, limit int) ([]*domain.WebhookDelivery, error)
+
+	Redeliver(ctx context.Context, subscriptionID, deliveryID int64) (*domain.WebhookDelivery, error)
+}
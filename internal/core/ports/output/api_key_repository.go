@@ -0,0 +1,26 @@
+package output
+
+import (
+	"context"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *domain.APIKey) (*domain.APIKey, error)
+
+	GetByID(ctx context.Context, id int64) (*domain.APIKey, error)
+
+	GetByPrefix(ctx context.Context, prefix string) (*domain.APIKey, error)
+
+	FindAll(ctx context.Context) ([]*domain.APIKey, error)
+
+	Revoke(ctx context.Context, id int64, revokedAt time.Time) error
+
+	SetExpiration(ctx context.Context, id int64, expiresAt time.Time) error
+
+	HasSuccessor(ctx context.Context, id int64) (bool, error)
+
+	UpdateLastUsed(ctx context.Context, id int64, usedAt time.Time) error
+}
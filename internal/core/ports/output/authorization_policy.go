@@ -0,0 +1,11 @@
+package output
+
+import (
+	"context"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+)
+
+type AuthorizationPolicy interface {
+	IsAllowed(ctx context.Context, principal *domain.Principal, action domain.Action) (bool, error)
+}
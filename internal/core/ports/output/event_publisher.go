@@ -0,0 +1,11 @@
+package output
+
+import (
+	"context"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+)
+
+type EventPublisher interface {
+	Publish(ctx context.Context, event domain.ItemEvent) error
+}
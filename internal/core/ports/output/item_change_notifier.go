@@ -0,0 +1,11 @@
+package output
+
+import (
+	"context"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+)
+
+type ItemChangeNotifier interface {
+	Notify(ctx context.Context, change domain.ItemChange)
+}
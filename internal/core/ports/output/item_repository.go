@@ -11,9 +11,13 @@ type ItemRepository interface {
 
 	GetByID(ctx context.Context, id int64) (*domain.Item, error)
 
+	GetByCode(ctx context.Context, code string) (*domain.Item, error)
+
+	CreateOrLockByCode(ctx context.Context, item *domain.Item) (*domain.Item, bool, error)
+
 	Update(ctx context.Context, item *domain.Item) error
 
-	Delete(ctx context.Context, id int64) error
+	Delete(ctx context.Context, item *domain.Item) error
 
 	FindAll(ctx context.Context, status string, limit, offset int) ([]*domain.Item, error)
 
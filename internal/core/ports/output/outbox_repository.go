@@ -0,0 +1,16 @@
+package output
+
+import (
+	"context"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+)
+
+type OutboxRepository interface {
+	ClaimPending(ctx context.Context, workerID string, limit int, lease time.Duration) ([]domain.OutboxEntry, error)
+
+	MarkPublished(ctx context.Context, id int64, publishedAt time.Time) error
+
+	MarkFailed(ctx context.Context, id int64, reason string, retryAt time.Time) error
+}
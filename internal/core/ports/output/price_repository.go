@@ -0,0 +1,22 @@
+package output
+
+import (
+	"context"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+)
+
+type PriceRepository interface {
+	FindHistory(ctx context.Context, itemID int64, limit int) ([]*domain.PriceHistoryEntry, error)
+
+	CreateSchedule(ctx context.Context, schedule *domain.ScheduledPrice) (*domain.ScheduledPrice, error)
+
+	GetSchedule(ctx context.Context, itemID, id int64) (*domain.ScheduledPrice, error)
+
+	FindSchedules(ctx context.Context, itemID int64) ([]*domain.ScheduledPrice, error)
+
+	UpdateSchedule(ctx context.Context, schedule *domain.ScheduledPrice) error
+
+	ClaimDueSchedules(ctx context.Context, workerID string, limit int, lease time.Duration) ([]*domain.ScheduledPrice, error)
+}
@@ -0,0 +1,22 @@
+package output
+
+import (
+	"context"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+)
+
+type PromotionRepository interface {
+	Create(ctx context.Context, promotion *domain.Promotion) (*domain.Promotion, error)
+
+	GetByID(ctx context.Context, id int64) (*domain.Promotion, error)
+
+	FindAll(ctx context.Context) ([]*domain.Promotion, error)
+
+	FindInEffect(ctx context.Context, now time.Time) ([]*domain.Promotion, error)
+
+	LastChangedAt(ctx context.Context, now time.Time) (time.Time, error)
+
+	Update(ctx context.Context, promotion *domain.Promotion) error
+}
@@ -0,0 +1,23 @@
+package output
+
+import (
+	"context"
+	"time"
+)
+
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+type RateLimitResult struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	ResetAfter time.Duration
+	RetryAfter time.Duration
+}
+
+type RateLimitStore interface {
+	Take(ctx context.Context, key string, limit RateLimit) (*RateLimitResult, error)
+}
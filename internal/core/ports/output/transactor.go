@@ -0,0 +1,37 @@
+package output
+
+import "context"
+
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type txHooksContextKey struct{}
+
+type TxHooks struct {
+	afterCommit []func()
+}
+
+func ContextWithTxHooks(ctx context.Context, hooks *TxHooks) context.Context {
+	return context.WithValue(ctx, txHooksContextKey{}, hooks)
+}
+
+func InTx(ctx context.Context) bool {
+	_, ok := ctx.Value(txHooksContextKey{}).(*TxHooks)
+	return ok
+}
+
+func AfterCommit(ctx context.Context, fn func()) {
+	hooks, ok := ctx.Value(txHooksContextKey{}).(*TxHooks)
+	if !ok {
+		fn()
+		return
+	}
+	hooks.afterCommit = append(hooks.afterCommit, fn)
+}
+
+func (h *TxHooks) Committed() {
+	for _, fn := range h.afterCommit {
+		fn()
+	}
+}
@@ -0,0 +1,32 @@
+package output
+
+import (
+	"context"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+)
+
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, subscription *domain.WebhookSubscription) (*domain.WebhookSubscription, error)
+
+	GetSubscription(ctx context.Context, id int64) (*domain.WebhookSubscription, error)
+
+	FindSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error)
+
+	DeleteSubscription(ctx context.Context, id int64) error
+
+	FindActiveSubscriptions(ctx context.Context, sellerID string) ([]*domain.WebhookSubscription, error)
+
+	UpdateSubscriptionHealth(ctx context.Context, subscription *domain.WebhookSubscription) error
+
+	CreateDelivery(ctx context.Context, delivery *domain.WebhookDelivery) (*domain.WebhookDelivery, error)
+
+	GetDelivery(ctx context.Context, subscriptionID, id int64) (*domain.WebhookDelivery, error)
+
+	FindDeliveries(ctx context.Context, subscriptionID int64, limit int) ([]*domain.WebhookDelivery, error)
+
+	ClaimDueDeliveries(ctx context.Context, workerID string, limit int, lease time.Duration) ([]domain.WebhookDeliveryJob, error)
+
+	UpdateDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error
+}
@@ -0,0 +1,9 @@
+package output
+
+import (
+	"context"
+)
+
+type WebhookSender interface {
+	Send(ctx context.Context, url string, headers map[string]string, body []byte) (int, error)
+}
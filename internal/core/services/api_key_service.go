@@ -0,0 +1,296 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+)
+
+const (
+	apiKeyPrefix = "mlv_"
+
+	apiKeyLastUsedResolution = time.Minute
+)
+
+var (
+	ErrAPIKeyNotFound = errors.New("chave de API não encontrada")
+
+	ErrInvalidAPIKey = errors.New("chave de API inválida ou expirada")
+
+	ErrInvalidAPIKeyData = errors.New("dados da chave de API inválidos")
+
+	ErrMissingAPIKeyName = fmt.Errorf("%w: nome é obrigatório", ErrInvalidAPIKeyData)
+
+	ErrMissingAPIKeyScopes = fmt.Errorf("%w: ao menos um escopo é obrigatório", ErrInvalidAPIKeyData)
+
+	ErrUnknownScope = fmt.Errorf("%w: escopo desconhecido", ErrInvalidAPIKeyData)
+
+	ErrExpirationInPast = fmt.Errorf("%w: data de expiração deve estar no futuro", ErrInvalidAPIKeyData)
+
+	ErrNegativeOverlap = fmt.Errorf("%w: período de sobreposição não pode ser negativo", ErrInvalidAPIKeyData)
+
+	ErrScopeNotGranted = fmt.Errorf("%w: escopo não concedido ao solicitante", ErrForbidden)
+
+	ErrAPIKeyNotActive = fmt.Errorf("%w: apenas chaves ativas podem ser rotacionadas", ErrInvalidAPIKeyData)
+
+	ErrAPIKeyAlreadyRotated = errors.New("chave de API já rotacionada")
+)
+
+type APIKeyService struct {
+	repo       output.APIKeyRepository
+	transactor output.Transactor
+	policy     output.AuthorizationPolicy
+}
+
+func NewAPIKeyService(repo output.APIKeyRepository, transactor output.Transactor, policy output.AuthorizationPolicy) *APIKeyService {
+	return &APIKeyService{
+		repo:       repo,
+		transactor: transactor,
+		policy:     policy,
+	}
+}
+
+func (s *APIKeyService) CreateAPIKey(ctx context.Context, name string, scopes []string, expiresAt *time.Time) (*domain.CreatedAPIKey, error) {
+
+	if err := authorize(ctx, s.policy, domain.ActionAPIKeyManage); err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(name) == "" {
+		return nil, ErrMissingAPIKeyName
+	}
+
+	if len(scopes) == 0 {
+		return nil, ErrMissingAPIKeyScopes
+	}
+
+	for _, scope := range scopes {
+		if !domain.Action(scope).IsValid() {
+			return nil, fmt.Errorf("%w %q", ErrUnknownScope, scope)
+		}
+	}
+
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, ErrExpirationInPast
+	}
+
+	return s.issue(ctx, name, scopes, expiresAt, nil)
+}
+
+func (s *APIKeyService) ListAPIKeys(ctx context.Context) ([]*domain.APIKey, error) {
+
+	if err := authorize(ctx, s.policy, domain.ActionAPIKeyManage); err != nil {
+		return nil, err
+	}
+
+	keys, err := s.repo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao recuperar chaves de API: %w", err)
+	}
+
+	return keys, nil
+}
+
+func (s *APIKeyService) RevokeAPIKey(ctx context.Context, id int64) error {
+
+	if err := authorize(ctx, s.policy, domain.ActionAPIKeyManage); err != nil {
+		return err
+	}
+
+	key, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("erro ao obter chave de API: %w", err)
+	}
+
+	if key == nil {
+		return ErrAPIKeyNotFound
+	}
+
+	if key.RevokedAt != nil {
+		return nil
+	}
+
+	err = s.repo.Revoke(ctx, id, time.Now())
+	if err != nil {
+		return fmt.Errorf("erro ao revogar chave de API: %w", err)
+	}
+
+	slog.InfoContext(ctx, "chave de API revogada", "api_key_id", id, "prefix", key.Prefix)
+
+	return nil
+}
+
+func (s *APIKeyService) RotateAPIKey(ctx context.Context, id int64, overlap time.Duration) (*domain.CreatedAPIKey, error) {
+
+	if err := authorize(ctx, s.policy, domain.ActionAPIKeyManage); err != nil {
+		return nil, err
+	}
+
+	if overlap < 0 {
+		return nil, ErrNegativeOverlap
+	}
+
+	var key *domain.APIKey
+	var created *domain.CreatedAPIKey
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		key, err = s.repo.GetByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("erro ao obter chave de API: %w", err)
+		}
+
+		if key == nil {
+			return ErrAPIKeyNotFound
+		}
+
+		now := time.Now()
+		if !key.IsActive(now) {
+			return ErrAPIKeyNotActive
+		}
+
+		rotated, err := s.repo.HasSuccessor(ctx, key.ID)
+		if err != nil {
+			return fmt.Errorf("erro ao verificar rotação da chave de API: %w", err)
+		}
+		if rotated {
+			return ErrAPIKeyAlreadyRotated
+		}
+
+		created, err = s.issue(ctx, key.Name, key.Scopes, key.ExpiresAt, &key.ID)
+		if err != nil {
+			return err
+		}
+
+		overlapEnd := now.Add(overlap)
+		if key.ExpiresAt == nil || overlapEnd.Before(*key.ExpiresAt) {
+			if err := s.repo.SetExpiration(ctx, key.ID, overlapEnd); err != nil {
+				return fmt.Errorf("erro ao expirar chave de API anterior: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "chave de API rotacionada", "api_key_id", key.ID, "new_api_key_id", created.APIKey.ID, "overlap", overlap)
+
+	return created, nil
+}
+
+func (s *APIKeyService) AuthenticateAPIKey(ctx context.Context, rawKey string) (*domain.Principal, error) {
+	prefix, secret, ok := parseAPIKey(rawKey)
+	if !ok {
+		return nil, ErrInvalidAPIKey
+	}
+
+	key, err := s.repo.GetByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao obter chave de API: %w", err)
+	}
+
+	if key == nil || subtle.ConstantTimeCompare([]byte(hashAPIKeySecret(secret)), []byte(key.SecretHash)) != 1 {
+		return nil, ErrInvalidAPIKey
+	}
+
+	now := time.Now()
+	if !key.IsActive(now) {
+		return nil, ErrInvalidAPIKey
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyLastUsedResolution {
+		err = s.repo.UpdateLastUsed(ctx, key.ID, now)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao registrar uso da chave de API: %w", err)
+		}
+	}
+
+	return key.Principal(), nil
+}
+
+func (s *APIKeyService) issue(ctx context.Context, name string, scopes []string, expiresAt *time.Time, rotatedFrom *int64) (*domain.CreatedAPIKey, error) {
+	if err := s.authorizeScopes(ctx, scopes); err != nil {
+		return nil, err
+	}
+
+	prefix, err := randomString(6, hex.EncodeToString)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao gerar chave de API: %w", err)
+	}
+
+	secret, err := randomString(32, base64.RawURLEncoding.EncodeToString)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao gerar chave de API: %w", err)
+	}
+
+	createdBy := ""
+	if principal, ok := domain.PrincipalFromContext(ctx); ok {
+		createdBy = principal.Subject
+	}
+
+	key := domain.NewAPIKey(name, prefix, hashAPIKeySecret(secret), scopes, createdBy, expiresAt)
+	key.RotatedFrom = rotatedFrom
+
+	savedKey, err := s.repo.Create(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao criar chave de API: %w", err)
+	}
+
+	slog.InfoContext(ctx, "chave de API criada", "api_key_id", savedKey.ID, "prefix", savedKey.Prefix)
+
+	return &domain.CreatedAPIKey{
+		APIKey: savedKey,
+		Secret: apiKeyPrefix + prefix + "." + secret,
+	}, nil
+}
+
+func (s *APIKeyService) authorizeScopes(ctx context.Context, scopes []string) error {
+	for _, scope := range scopes {
+		err := authorize(ctx, s.policy, domain.Action(scope))
+		if errors.Is(err, ErrForbidden) {
+			return fmt.Errorf("%w %q", ErrScopeNotGranted, scope)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseAPIKey(rawKey string) (string, string, bool) {
+	rest, found := strings.CutPrefix(rawKey, apiKeyPrefix)
+	if !found {
+		return "", "", false
+	}
+
+	prefix, secret, found := strings.Cut(rest, ".")
+	if !found || prefix == "" || secret == "" {
+		return "", "", false
+	}
+
+	return prefix, secret, true
+}
+
+func hashAPIKeySecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomString(size int, encode func([]byte) string) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return encode(buf), nil
+}
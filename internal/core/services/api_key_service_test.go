@@ -0,0 +1,241 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/output/authz"
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/services"
+)
+
+type memoryAPIKeyRepository struct {
+	mu   sync.Mutex
+	keys map[int64]*domain.APIKey
+
+	expirationErr error
+}
+
+func newMemoryAPIKeyRepository() *memoryAPIKeyRepository {
+	return &memoryAPIKeyRepository{keys: make(map[int64]*domain.APIKey)}
+}
+
+func (r *memoryAPIKeyRepository) Create(ctx context.Context, key *domain.APIKey) (*domain.APIKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key.ID = int64(len(r.keys) + 1)
+	key.SellerID, _ = domain.SellerIDFromContext(ctx)
+	r.keys[key.ID] = key
+	return key, nil
+}
+
+func (r *memoryAPIKeyRepository) GetByID(ctx context.Context, id int64) (*domain.APIKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.keys[id], nil
+}
+
+func (r *memoryAPIKeyRepository) GetByPrefix(ctx context.Context, prefix string) (*domain.APIKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, key := range r.keys {
+		if key.Prefix == prefix {
+			return key, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryAPIKeyRepository) FindAll(ctx context.Context) ([]*domain.APIKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	keys := make([]*domain.APIKey, 0, len(r.keys))
+	for _, key := range r.keys {
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (r *memoryAPIKeyRepository) Revoke(ctx context.Context, id int64, revokedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.keys[id].RevokedAt = &revokedAt
+	return nil
+}
+
+func (r *memoryAPIKeyRepository) SetExpiration(ctx context.Context, id int64, expiresAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.expirationErr != nil {
+		return r.expirationErr
+	}
+	r.keys[id].ExpiresAt = &expiresAt
+	return nil
+}
+
+func (r *memoryAPIKeyRepository) HasSuccessor(ctx context.Context, id int64) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, key := range r.keys {
+		if key.RotatedFrom != nil && *key.RotatedFrom == id {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type rollbackTransactor struct {
+	repo *memoryAPIKeyRepository
+}
+
+func (t rollbackTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	t.repo.mu.Lock()
+	snapshot := make(map[int64]domain.APIKey, len(t.repo.keys))
+	for id, key := range t.repo.keys {
+		snapshot[id] = *key
+	}
+	t.repo.mu.Unlock()
+
+	err := fn(ctx)
+	if err != nil {
+		t.repo.mu.Lock()
+		t.repo.keys = make(map[int64]*domain.APIKey, len(snapshot))
+		for id, key := range snapshot {
+			key := key
+			t.repo.keys[id] = &key
+		}
+		t.repo.mu.Unlock()
+	}
+	return err
+}
+
+func (r *memoryAPIKeyRepository) UpdateLastUsed(ctx context.Context, id int64, usedAt time.Time) error {
+	return nil
+}
+
+func TestCreateAPIKeyRejectsScopesTheCallerDoesNotHold(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *domain.Principal
+		scopes    []string
+		wantErr   error
+	}{
+		{
+			name:      "chave com api-key:manage não cria chave com item:delete",
+			principal: &domain.Principal{Subject: "api-key:abc", SellerID: "seller-1", Scopes: []string{string(domain.ActionAPIKeyManage)}},
+			scopes:    []string{string(domain.ActionItemDelete)},
+			wantErr:   services.ErrScopeNotGranted,
+		},
+		{
+			name:      "chave com api-key:manage não cria chave com webhook:manage",
+			principal: &domain.Principal{Subject: "api-key:abc", SellerID: "seller-1", Scopes: []string{string(domain.ActionAPIKeyManage), string(domain.ActionItemRead)}},
+			scopes:    []string{string(domain.ActionItemRead), string(domain.ActionWebhookManage)},
+			wantErr:   services.ErrScopeNotGranted,
+		},
+		{
+			name:      "chave pode delegar escopos que possui",
+			principal: &domain.Principal{Subject: "api-key:abc", SellerID: "seller-1", Scopes: []string{string(domain.ActionAPIKeyManage), string(domain.ActionItemRead)}},
+			scopes:    []string{string(domain.ActionItemRead)},
+		},
+		{
+			name:      "admin pode conceder qualquer escopo",
+			principal: &domain.Principal{Subject: "user-1", SellerID: "seller-1", Roles: []string{domain.RoleAdmin}},
+			scopes:    []string{string(domain.ActionItemDelete), string(domain.ActionWebhookManage)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMemoryAPIKeyRepository()
+			service := services.NewAPIKeyService(repo, passthroughTransactor{}, authz.NewRolePolicy())
+			ctx := domain.ContextWithPrincipal(context.Background(), tt.principal)
+
+			_, err := service.CreateAPIKey(ctx, "integração", tt.scopes, nil)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("erro inesperado: %v", err)
+				}
+				return
+			}
+
+			if !errors.Is(err, tt.wantErr) || !errors.Is(err, services.ErrForbidden) {
+				t.Fatalf("esperava %v, obteve %v", tt.wantErr, err)
+			}
+			if len(repo.keys) != 0 {
+				t.Fatalf("nenhuma chave deveria ter sido criada, obteve %d", len(repo.keys))
+			}
+		})
+	}
+}
+
+func TestRotateAPIKeyRequiresTheRotatedScopes(t *testing.T) {
+	repo := newMemoryAPIKeyRepository()
+	service := services.NewAPIKeyService(repo, passthroughTransactor{}, authz.NewRolePolicy())
+
+	admin := domain.ContextWithPrincipal(context.Background(), &domain.Principal{Subject: "user-1", SellerID: "seller-1", Roles: []string{domain.RoleAdmin}})
+	created, err := service.CreateAPIKey(admin, "integração", []string{string(domain.ActionItemDelete)}, nil)
+	if err != nil {
+		t.Fatalf("erro ao criar chave: %v", err)
+	}
+
+	manager := domain.ContextWithPrincipal(context.Background(), &domain.Principal{Subject: "api-key:abc", SellerID: "seller-1", Scopes: []string{string(domain.ActionAPIKeyManage)}})
+	_, err = service.RotateAPIKey(manager, created.APIKey.ID, time.Minute)
+	if !errors.Is(err, services.ErrScopeNotGranted) {
+		t.Fatalf("esperava ErrScopeNotGranted, obteve %v", err)
+	}
+
+	if key := repo.keys[created.APIKey.ID]; key.ExpiresAt != nil {
+		t.Fatalf("a chave original não deveria ter sido expirada")
+	}
+}
+
+func TestRotateAPIKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		rotations     int
+		expirationErr error
+		wantErr       error
+		wantKeys      int
+	}{
+		{name: "rotação cria a nova chave e encurta a anterior", rotations: 1, wantKeys: 2},
+		{name: "chave já rotacionada não gera segunda sucessora", rotations: 2, wantErr: services.ErrAPIKeyAlreadyRotated, wantKeys: 2},
+		{name: "falha ao expirar a anterior desfaz a nova chave", rotations: 1, expirationErr: errors.New("conexão perdida"), wantKeys: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMemoryAPIKeyRepository()
+			service := services.NewAPIKeyService(repo, rollbackTransactor{repo: repo}, authz.NewRolePolicy())
+			admin := roleContext(domain.RoleAdmin)
+
+			original, err := service.CreateAPIKey(admin, "integração", []string{string(domain.ActionItemRead)}, nil)
+			if err != nil {
+				t.Fatalf("erro ao criar chave: %v", err)
+			}
+			repo.expirationErr = tt.expirationErr
+
+			for i := 0; i < tt.rotations; i++ {
+				_, err = service.RotateAPIKey(admin, original.APIKey.ID, time.Hour)
+			}
+
+			switch {
+			case tt.expirationErr != nil:
+				if !errors.Is(err, tt.expirationErr) {
+					t.Fatalf("esperava %v, obteve %v", tt.expirationErr, err)
+				}
+			case !errors.Is(err, tt.wantErr):
+				t.Fatalf("esperava %v, obteve %v", tt.wantErr, err)
+			}
+
+			if len(repo.keys) != tt.wantKeys {
+				t.Fatalf("chaves = %d, esperado %d", len(repo.keys), tt.wantKeys)
+			}
+			if expiresAt := repo.keys[original.APIKey.ID].ExpiresAt; (expiresAt != nil) != (tt.expirationErr == nil) {
+				t.Fatalf("expiração da chave original = %v", expiresAt)
+			}
+		})
+	}
+}
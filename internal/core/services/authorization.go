@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+)
+
+var ErrForbidden = errors.New("operação não permitida para o usuário")
+
+func authorize(ctx context.Context, policy output.AuthorizationPolicy, actions ...domain.Action) error {
+	principal, _ := domain.PrincipalFromContext(ctx)
+
+	for _, action := range actions {
+		allowed, err := policy.IsAllowed(ctx, principal, action)
+		if err != nil {
+			return fmt.Errorf("erro ao verificar permissões: %w", err)
+		}
+		if !allowed {
+			slog.WarnContext(ctx, "acesso negado", "action", action)
+			return fmt.Errorf("%w: %s", ErrForbidden, action)
+		}
+	}
+
+	return requireSeller(ctx)
+}
+
+func authorizeAny(ctx context.Context, policy output.AuthorizationPolicy, actions ...domain.Action) error {
+	principal, _ := domain.PrincipalFromContext(ctx)
+
+	for _, action := range actions {
+		allowed, err := policy.IsAllowed(ctx, principal, action)
+		if err != nil {
+			return fmt.Errorf("erro ao verificar permissões: %w", err)
+		}
+		if allowed {
+			return requireSeller(ctx)
+		}
+	}
+
+	slog.WarnContext(ctx, "acesso negado", "actions", actions)
+	return fmt.Errorf("%w: %s", ErrForbidden, actions[0])
+}
+
+func requireSeller(ctx context.Context) error {
+	if _, ok := domain.SellerIDFromContext(ctx); !ok {
+		return fmt.Errorf("%w: %v", ErrForbidden, domain.ErrMissingSeller)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+)
+
+const itemChangeSubscriberBuffer = 64
+
+type itemChangeSubscriber struct {
+	sellerID string
+	filter   domain.ItemChangeFilter
+	changes  chan domain.ItemChange
+}
+
+type ItemChangeHub struct {
+	policy output.AuthorizationPolicy
+
+	mu          sync.Mutex
+	sequence    int64
+	buffer      []domain.ItemChange
+	next        int
+	full        bool
+	subscribers map[*itemChangeSubscriber]struct{}
+}
+
+func NewItemChangeHub(policy output.AuthorizationPolicy, bufferSize int) *ItemChangeHub {
+	if bufferSize <= 0 {
+		bufferSize = 1
+	}
+
+	return &ItemChangeHub{
+		policy:      policy,
+		buffer:      make([]domain.ItemChange, bufferSize),
+		subscribers: make(map[*itemChangeSubscriber]struct{}),
+	}
+}
+
+func (h *ItemChangeHub) Notify(ctx context.Context, change domain.ItemChange) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.sequence++
+	change.Sequence = h.sequence
+
+	h.buffer[h.next] = change
+	h.next = (h.next + 1) % len(h.buffer)
+	if h.next == 0 {
+		h.full = true
+	}
+
+	for sub := range h.subscribers {
+		if sub.sellerID != change.SellerID || !sub.filter.Matches(change) {
+			continue
+		}
+
+		select {
+		case sub.changes <- change:
+		default:
+			slog.WarnContext(ctx, "assinante do stream de itens removido por lentidão", "seller_id", sub.sellerID)
+			delete(h.subscribers, sub)
+			close(sub.changes)
+		}
+	}
+}
+
+func (h *ItemChangeHub) Subscribe(ctx context.Context, filter domain.ItemChangeFilter, lastSequence int64) (*domain.ItemChangeSubscription, error) {
+	if err := authorize(ctx, h.policy, domain.ActionItemRead); err != nil {
+		return nil, err
+	}
+
+	sellerID, _ := domain.SellerIDFromContext(ctx)
+
+	sub := &itemChangeSubscriber{
+		sellerID: sellerID,
+		filter:   filter,
+		changes:  make(chan domain.ItemChange, itemChangeSubscriberBuffer),
+	}
+
+	h.mu.Lock()
+	replay, truncated := h.replaySince(lastSequence, sub)
+	h.subscribers[sub] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	closeSubscription := func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			if _, ok := h.subscribers[sub]; ok {
+				delete(h.subscribers, sub)
+				close(sub.changes)
+			}
+		})
+	}
+
+	return &domain.ItemChangeSubscription{
+		Replay:    replay,
+		Truncated: truncated,
+		Changes:   sub.changes,
+		Close:     closeSubscription,
+	}, nil
+}
+
+func (h *ItemChangeHub) replaySince(lastSequence int64, sub *itemChangeSubscriber) ([]domain.ItemChange, bool) {
+	if lastSequence <= 0 {
+		return nil, false
+	}
+
+	ordered := h.ordered()
+	truncated := lastSequence > h.sequence
+	if len(ordered) > 0 && lastSequence < ordered[0].Sequence-1 {
+		truncated = true
+	}
+
+	var replay []domain.ItemChange
+	for _, change := range ordered {
+		if change.Sequence <= lastSequence {
+			continue
+		}
+		if change.SellerID == sub.sellerID && sub.filter.Matches(change) {
+			replay = append(replay, change)
+		}
+	}
+
+	return replay, truncated
+}
+
+func (h *ItemChangeHub) ordered() []domain.ItemChange {
+	if !h.full {
+		return append([]domain.ItemChange(nil), h.buffer[:h.next]...)
+	}
+
+	ordered := make([]domain.ItemChange, 0, len(h.buffer))
+	ordered = append(ordered, h.buffer[h.next:]...)
+	ordered = append(ordered, h.buffer[:h.next]...)
+	return ordered
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"math"
 
 	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
@@ -13,54 +14,108 @@ import (
 var (
 	ErrItemNotFound = errors.New("item não encontrado")
 
-	ErrDuplicateCode = errors.New("um item com este código já existe")
+	ErrDuplicateCode = domain.ErrDuplicateItemCode
 
 	ErrInvalidData = errors.New("dados do item inválidos")
+
+	ErrMissingItemFields = fmt.Errorf("%w: código, título e descrição são obrigatórios", ErrInvalidData)
+
+	ErrInvalidPrice = fmt.Errorf("%w: preço deve ser maior que 0", ErrInvalidData)
+
+	ErrNegativeStock = fmt.Errorf("%w: estoque não pode ser negativo", ErrInvalidData)
 )
 
 type ItemService struct {
-	repo output.ItemRepository
+	repo       output.ItemRepository
+	transactor output.Transactor
+	policy     output.AuthorizationPolicy
+	notifier   output.ItemChangeNotifier
 }
 
-func NewItemService(repo output.ItemRepository) *ItemService {
+func NewItemService(repo output.ItemRepository, transactor output.Transactor, policy output.AuthorizationPolicy, notifier output.ItemChangeNotifier) *ItemService {
 	return &ItemService{
-		repo: repo,
+		repo:       repo,
+		transactor: transactor,
+		policy:     policy,
+		notifier:   notifier,
+	}
+}
+
+func (s *ItemService) notify(ctx context.Context, eventType domain.EventType, item *domain.Item) {
+	if s.notifier == nil {
+		return
 	}
+	s.notifier.Notify(ctx, domain.NewItemChange(eventType, item))
 }
 
-func (s *ItemService) CreateItem(ctx context.Context, code, title, description string, price, stock int64) (*domain.Item, error) {
+func (s *ItemService) authorize(ctx context.Context, actions ...domain.Action) error {
+	return authorize(ctx, s.policy, actions...)
+}
 
+func (s *ItemService) authorizeAny(ctx context.Context, actions ...domain.Action) error {
+	return authorizeAny(ctx, s.policy, actions...)
+}
+
+func validateItemData(code, title, description string, price, stock int64) error {
 	if code == "" || title == "" || description == "" {
-		return nil, fmt.Errorf("%w: código, título e descrição são obrigatórios", ErrInvalidData)
+		return ErrMissingItemFields
 	}
 
 	if price <= 0 {
-		return nil, fmt.Errorf("%w: preço deve ser maior que 0", ErrInvalidData)
+		return ErrInvalidPrice
 	}
 
 	if stock < 0 {
-		return nil, fmt.Errorf("%w: estoque não pode ser negativo", ErrInvalidData)
+		return ErrNegativeStock
 	}
 
-	exists, err := s.repo.ExistsByCode(ctx, code, 0)
-	if err != nil {
-		return nil, fmt.Errorf("erro ao verificar unicidade do código: %w", err)
+	return nil
+}
+
+func (s *ItemService) CreateItem(ctx context.Context, code, title, description, category string, price, stock int64) (*domain.Item, error) {
+
+	if err := s.authorize(ctx, domain.ActionItemCreate); err != nil {
+		return nil, err
 	}
-	if exists {
-		return nil, ErrDuplicateCode
+
+	if err := validateItemData(code, title, description, price, stock); err != nil {
+		return nil, err
 	}
 
-	item := domain.NewItem(code, title, description, price, stock)
+	var savedItem *domain.Item
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		exists, err := s.repo.ExistsByCode(ctx, code, 0)
+		if err != nil {
+			return fmt.Errorf("erro ao verificar unicidade do código: %w", err)
+		}
+		if exists {
+			return ErrDuplicateCode
+		}
 
-	savedItem, err := s.repo.Create(ctx, item)
+		item := domain.NewItem(code, title, description, category, price, stock)
+
+		savedItem, err = s.repo.Create(ctx, item)
+		if err != nil {
+			return fmt.Errorf("erro ao criar item: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("erro ao criar item: %w", err)
+		return nil, err
 	}
 
+	slog.InfoContext(ctx, "item criado", "item_id", savedItem.ID, "code", savedItem.Code)
+
+	s.notify(ctx, domain.EventItemCreated, savedItem)
+
 	return savedItem, nil
 }
 
 func (s *ItemService) GetItem(ctx context.Context, id int64) (*domain.Item, error) {
+	if err := s.authorize(ctx, domain.ActionItemRead); err != nil {
+		return nil, err
+	}
+
 	item, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("erro ao obter item: %w", err)
@@ -73,70 +128,232 @@ func (s *ItemService) GetItem(ctx context.Context, id int64) (*domain.Item, erro
 	return item, nil
 }
 
-func (s *ItemService) UpdateItem(ctx context.Context, id int64, code, title, description string, price, stock int64) (*domain.Item, error) {
+func (s *ItemService) UpdateItem(ctx context.Context, id int64, code, title, description string, category *string, price, stock int64) (*domain.Item, error) {
 
-	if code == "" || title == "" || description == "" {
-		return nil, fmt.Errorf("%w: código, título e descrição são obrigatórios", ErrInvalidData)
+	if err := s.authorizeAny(ctx, domain.ActionItemUpdate, domain.ActionItemChangeStock); err != nil {
+		return nil, err
 	}
 
-	if price <= 0 {
-		return nil, fmt.Errorf("%w: preço deve ser maior que 0", ErrInvalidData)
+	if err := validateItemData(code, title, description, price, stock); err != nil {
+		return nil, err
 	}
 
-	if stock < 0 {
-		return nil, fmt.Errorf("%w: estoque não pode ser negativo", ErrInvalidData)
-	}
+	var item *domain.Item
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		item, err = s.repo.GetByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("erro ao obter item: %w", err)
+		}
 
-	item, err := s.repo.GetByID(ctx, id)
+		if item == nil {
+			return ErrItemNotFound
+		}
+
+		category := categoryOrCurrent(category, item.Category)
+
+		if err := s.authorize(ctx, updateActions(item, code, title, description, category, price, stock)...); err != nil {
+			return err
+		}
+
+		if item.Code != code {
+			exists, err := s.repo.ExistsByCode(ctx, code, id)
+			if err != nil {
+				return fmt.Errorf("erro ao verificar unicidade do código: %w", err)
+			}
+			if exists {
+				return ErrDuplicateCode
+			}
+		}
+
+		item.UpdateItem(code, title, description, category, price, stock)
+
+		err = s.repo.Update(ctx, item)
+		if err != nil {
+			return fmt.Errorf("erro ao atualizar item: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("erro ao obter item: %w", err)
+		return nil, err
 	}
 
-	if item == nil {
-		return nil, ErrItemNotFound
+	slog.InfoContext(ctx, "item atualizado", "item_id", item.ID, "code", item.Code)
+
+	s.notify(ctx, domain.EventItemUpdated, item)
+
+	return item, nil
+}
+
+func categoryOrCurrent(category *string, current string) string {
+	if category == nil {
+		return current
+	}
+	return *category
+}
+
+func updateActions(item *domain.Item, code, title, description, category string, price, stock int64) []domain.Action {
+	var actions []domain.Action
+
+	if item.Code != code || item.Title != title || item.Description != description || item.Category != category || item.Price != price {
+		actions = append(actions, domain.ActionItemUpdate)
+	}
+
+	if item.Stock != stock {
+		actions = append(actions, domain.ActionItemChangeStock)
+	}
+
+	return actions
+}
+
+func (s *ItemService) DeleteItem(ctx context.Context, id int64) error {
+
+	if err := s.authorize(ctx, domain.ActionItemDelete); err != nil {
+		return err
 	}
 
-	if item.Code != code {
-		exists, err := s.repo.ExistsByCode(ctx, code, id)
+	var item *domain.Item
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		item, err = s.repo.GetByID(ctx, id)
 		if err != nil {
-			return nil, fmt.Errorf("erro ao verificar unicidade do código: %w", err)
+			return fmt.Errorf("erro ao obter item: %w", err)
 		}
-		if exists {
-			return nil, ErrDuplicateCode
+
+		if item == nil {
+			return ErrItemNotFound
 		}
+
+		item.MarkDeleted()
+
+		err = s.repo.Delete(ctx, item)
+		if err != nil {
+			return fmt.Errorf("erro ao excluir item: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
-	item.UpdateItem(code, title, description, price, stock)
+	slog.InfoContext(ctx, "item excluído", "item_id", id)
 
-	err = s.repo.Update(ctx, item)
+	s.notify(ctx, domain.EventItemDeleted, item)
+
+	return nil
+}
+
+func (s *ItemService) GetItemByCode(ctx context.Context, code string) (*domain.Item, error) {
+	if err := s.authorize(ctx, domain.ActionItemRead); err != nil {
+		return nil, err
+	}
+
+	item, err := s.repo.GetByCode(ctx, code)
 	if err != nil {
-		return nil, fmt.Errorf("erro ao atualizar item: %w", err)
+		return nil, fmt.Errorf("erro ao obter item: %w", err)
+	}
+
+	if item == nil {
+		return nil, ErrItemNotFound
 	}
 
 	return item, nil
 }
 
-func (s *ItemService) DeleteItem(ctx context.Context, id int64) error {
+func (s *ItemService) UpsertItemByCode(ctx context.Context, code, title, description string, category *string, price, stock int64) (*domain.Item, bool, error) {
 
-	item, err := s.repo.GetByID(ctx, id)
+	if err := s.authorizeAny(ctx, domain.ActionItemCreate, domain.ActionItemUpdate, domain.ActionItemChangeStock); err != nil {
+		return nil, false, err
+	}
+
+	if err := validateItemData(code, title, description, price, stock); err != nil {
+		return nil, false, err
+	}
+
+	var item *domain.Item
+	var created bool
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		item, created, err = s.repo.CreateOrLockByCode(ctx, domain.NewItem(code, title, description, categoryOrCurrent(category, ""), price, stock))
+		if err != nil {
+			return fmt.Errorf("erro ao salvar item: %w", err)
+		}
+
+		if created {
+			return s.authorize(ctx, domain.ActionItemCreate)
+		}
+
+		category := categoryOrCurrent(category, item.Category)
+
+		if err := s.authorize(ctx, updateActions(item, code, title, description, category, price, stock)...); err != nil {
+			return err
+		}
+
+		item.UpdateItem(code, title, description, category, price, stock)
+
+		if err := s.repo.Update(ctx, item); err != nil {
+			return fmt.Errorf("erro ao salvar item: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("error getting item: %w", err)
+		return nil, false, err
 	}
 
-	if item == nil {
-		return ErrItemNotFound
+	slog.InfoContext(ctx, "item salvo por código", "item_id", item.ID, "code", code, "created", created)
+
+	if created {
+		s.notify(ctx, domain.EventItemCreated, item)
+	} else {
+		s.notify(ctx, domain.EventItemUpdated, item)
 	}
 
-	err = s.repo.Delete(ctx, id)
+	return item, created, nil
+}
+
+func (s *ItemService) DeleteItemByCode(ctx context.Context, code string) error {
+
+	if err := s.authorize(ctx, domain.ActionItemDelete); err != nil {
+		return err
+	}
+
+	var item *domain.Item
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		item, err = s.repo.GetByCode(ctx, code)
+		if err != nil {
+			return fmt.Errorf("erro ao obter item: %w", err)
+		}
+
+		if item == nil {
+			return ErrItemNotFound
+		}
+
+		item.MarkDeleted()
+
+		err = s.repo.Delete(ctx, item)
+		if err != nil {
+			return fmt.Errorf("erro ao excluir item: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("erro ao excluir item: %w", err)
+		return err
 	}
 
+	slog.InfoContext(ctx, "item excluído", "item_id", item.ID, "code", code)
+
+	s.notify(ctx, domain.EventItemDeleted, item)
+
 	return nil
 }
 
 func (s *ItemService) ListItems(ctx context.Context, status string, limit, page int) (*domain.PagedItems, error) {
 
+	if err := s.authorize(ctx, domain.ActionItemRead); err != nil {
+		return nil, err
+	}
+
 	if limit <= 0 {
 		limit = 10
 	} else if limit > 20 {
@@ -0,0 +1,208 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/output/authz"
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+	"github.com/fesbarbosa/melivendas-api/internal/core/services"
+)
+
+type memoryItemRepository struct {
+	output.ItemRepository
+
+	mu    sync.Mutex
+	items map[int64]*domain.Item
+	calls int
+}
+
+func newMemoryItemRepository(items ...*domain.Item) *memoryItemRepository {
+	r := &memoryItemRepository{items: make(map[int64]*domain.Item)}
+	for _, item := range items {
+		r.items[item.ID] = item
+	}
+	return r
+}
+
+func (r *memoryItemRepository) GetByID(ctx context.Context, id int64) (*domain.Item, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls++
+	if item, ok := r.items[id]; ok {
+		copied := *item
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+func (r *memoryItemRepository) CreateOrLockByCode(ctx context.Context, item *domain.Item) (*domain.Item, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls++
+	for _, existing := range r.items {
+		if existing.Code == item.Code {
+			copied := *existing
+			return &copied, false, nil
+		}
+	}
+	item.ID = int64(len(r.items) + 1)
+	r.items[item.ID] = item
+	return item, true, nil
+}
+
+func (r *memoryItemRepository) Update(ctx context.Context, item *domain.Item) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls++
+	r.items[item.ID] = item
+	return nil
+}
+
+func (r *memoryItemRepository) ExistsByCode(ctx context.Context, code string, excludeID int64) (bool, error) {
+	return false, nil
+}
+
+type passthroughTransactor struct{}
+
+func (passthroughTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func roleContext(role string) context.Context {
+	return domain.ContextWithPrincipal(context.Background(), &domain.Principal{Subject: "user-1", SellerID: "seller-1", Roles: []string{role}})
+}
+
+func TestUpsertItemByCodeAuthorizesFromTheExistingRow(t *testing.T) {
+	existing := func() *domain.Item {
+		return &domain.Item{ID: 1, SellerID: "seller-1", Code: "ABC", Title: "Item", Description: "Descrição", Price: 100, Stock: 5, Status: domain.ItemStatusActive}
+	}
+
+	tests := []struct {
+		name      string
+		role      string
+		code      string
+		title     string
+		stock     int64
+		wantErr   error
+		wantCalls bool
+	}{
+		{name: "operador de estoque altera só o estoque", role: domain.RoleInventoryOperator, code: "ABC", title: "Item", stock: 9, wantCalls: true},
+		{name: "operador de estoque reenvia o item sem alterações", role: domain.RoleInventoryOperator, code: "ABC", title: "Item", stock: 5, wantCalls: true},
+		{name: "operador de estoque não altera o título", role: domain.RoleInventoryOperator, code: "ABC", title: "Outro", stock: 5, wantErr: services.ErrForbidden, wantCalls: true},
+		{name: "operador de estoque não cria itens", role: domain.RoleInventoryOperator, code: "NOVO", title: "Item", stock: 5, wantErr: services.ErrForbidden, wantCalls: true},
+		{name: "editor altera o título", role: domain.RoleEditor, code: "ABC", title: "Outro", stock: 5, wantCalls: true},
+		{name: "editor não altera o estoque", role: domain.RoleEditor, code: "ABC", title: "Item", stock: 9, wantErr: services.ErrForbidden, wantCalls: true},
+		{name: "leitor é barrado antes de consultar o repositório", role: domain.RoleViewer, code: "ABC", title: "Item", stock: 5, wantErr: services.ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMemoryItemRepository(existing())
+			service := services.NewItemService(repo, passthroughTransactor{}, authz.NewRolePolicy(), nil)
+
+			_, _, err := service.UpsertItemByCode(roleContext(tt.role), tt.code, tt.title, "Descrição", nil, 100, tt.stock)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("esperava %v, obteve %v", tt.wantErr, err)
+			}
+			if (repo.calls > 0) != tt.wantCalls {
+				t.Fatalf("chamadas ao repositório = %d, esperava chamadas = %v", repo.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestUpdateItemChecksPermissionBeforeLookup(t *testing.T) {
+	tests := []struct {
+		name      string
+		role      string
+		wantErr   error
+		wantCalls bool
+	}{
+		{name: "leitor recebe acesso negado sem revelar se o item existe", role: domain.RoleViewer, wantErr: services.ErrForbidden},
+		{name: "editor recebe item não encontrado", role: domain.RoleEditor, wantErr: services.ErrItemNotFound, wantCalls: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMemoryItemRepository()
+			service := services.NewItemService(repo, passthroughTransactor{}, authz.NewRolePolicy(), nil)
+
+			_, err := service.UpdateItem(roleContext(tt.role), 42, "ABC", "Item", "Descrição", nil, 100, 5)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("esperava %v, obteve %v", tt.wantErr, err)
+			}
+			if (repo.calls > 0) != tt.wantCalls {
+				t.Fatalf("chamadas ao repositório = %d, esperava chamadas = %v", repo.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestUpdateItemAuthorizesOnlyChangedFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		role    string
+		title   string
+		stock   int64
+		wantErr error
+	}{
+		{name: "operador de estoque reenvia o estoque atual", role: domain.RoleInventoryOperator, title: "Item", stock: 5},
+		{name: "operador de estoque altera o estoque", role: domain.RoleInventoryOperator, title: "Item", stock: 9},
+		{name: "operador de estoque não altera o título", role: domain.RoleInventoryOperator, title: "Outro", stock: 5, wantErr: services.ErrForbidden},
+		{name: "editor reenvia o item sem alterações", role: domain.RoleEditor, title: "Item", stock: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMemoryItemRepository(&domain.Item{ID: 1, SellerID: "seller-1", Code: "ABC", Title: "Item", Description: "Descrição", Price: 100, Stock: 5, Status: domain.ItemStatusActive})
+			service := services.NewItemService(repo, passthroughTransactor{}, authz.NewRolePolicy(), nil)
+
+			_, err := service.UpdateItem(roleContext(tt.role), 1, "ABC", tt.title, "Descrição", nil, 100, tt.stock)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("esperava %v, obteve %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestOmittedCategoryKeepsTheCurrentValue(t *testing.T) {
+	empty, other := "", "casa"
+
+	tests := []struct {
+		name     string
+		category *string
+		want     string
+	}{
+		{name: "categoria omitida é mantida", category: nil, want: "moda"},
+		{name: "categoria vazia é removida", category: &empty, want: ""},
+		{name: "categoria informada é substituída", category: &other, want: "casa"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, upsert := range []bool{false, true} {
+				repo := newMemoryItemRepository(&domain.Item{ID: 1, SellerID: "seller-1", Code: "ABC", Title: "Item", Description: "Descrição", Category: "moda", Price: 100, Stock: 5, Status: domain.ItemStatusActive})
+				service := services.NewItemService(repo, passthroughTransactor{}, authz.NewRolePolicy(), nil)
+				ctx := roleContext(domain.RoleAdmin)
+
+				var item *domain.Item
+				var err error
+				if upsert {
+					item, _, err = service.UpsertItemByCode(ctx, "ABC", "Novo título", "Descrição", tt.category, 100, 5)
+				} else {
+					item, err = service.UpdateItem(ctx, 1, "ABC", "Novo título", "Descrição", tt.category, 100, 5)
+				}
+				if err != nil {
+					t.Fatalf("erro inesperado (upsert=%v): %v", upsert, err)
+				}
+				if item.Category != tt.want {
+					t.Fatalf("categoria = %q, esperado %q (upsert=%v)", item.Category, tt.want, upsert)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+)
+
+const maxOutboxRetryDelay = 5 * time.Minute
+
+type OutboxRelay struct {
+	repo      output.OutboxRepository
+	publisher output.EventPublisher
+	workerID  string
+	batchSize int
+	interval  time.Duration
+	lease     time.Duration
+}
+
+func NewOutboxRelay(repo output.OutboxRepository, publisher output.EventPublisher, workerID string, batchSize int, interval, lease time.Duration) *OutboxRelay {
+	return &OutboxRelay{
+		repo:      repo,
+		publisher: publisher,
+		workerID:  workerID,
+		batchSize: batchSize,
+		interval:  interval,
+		lease:     lease,
+	}
+}
+
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	slog.InfoContext(ctx, "relay do outbox iniciado", "worker_id", r.workerID)
+
+	for {
+		for {
+			relayed, err := r.RelayBatch(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "falha ao processar outbox", "error", err)
+				break
+			}
+			if relayed < r.batchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("relay do outbox encerrado", "worker_id", r.workerID)
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *OutboxRelay) RelayBatch(ctx context.Context) (int, error) {
+	entries, err := r.repo.ClaimPending(ctx, r.workerID, r.batchSize, r.lease)
+	if err != nil {
+		return 0, fmt.Errorf("erro ao obter eventos pendentes: %w", err)
+	}
+
+	for _, entry := range entries {
+		if err := r.publisher.Publish(ctx, entry.Event); err != nil {
+			retryAt := time.Now().Add(outboxRetryDelay(entry.Attempts))
+			slog.WarnContext(ctx, "falha ao publicar evento",
+				"event_id", entry.Event.ID,
+				"event_type", entry.Event.Type,
+				"attempts", entry.Attempts+1,
+				"retry_at", retryAt,
+				"error", err,
+			)
+
+			if err := r.repo.MarkFailed(ctx, entry.ID, err.Error(), retryAt); err != nil {
+				return 0, fmt.Errorf("erro ao registrar falha do evento %s: %w", entry.Event.ID, err)
+			}
+			continue
+		}
+
+		if err := r.repo.MarkPublished(ctx, entry.ID, time.Now()); err != nil {
+			return 0, fmt.Errorf("erro ao marcar evento %s como publicado: %w", entry.Event.ID, err)
+		}
+	}
+
+	return len(entries), nil
+}
+
+func outboxRetryDelay(attempts int) time.Duration {
+	delay := time.Duration(math.Pow(2, float64(attempts))) * time.Second
+	if delay <= 0 || delay > maxOutboxRetryDelay {
+		return maxOutboxRetryDelay
+	}
+	return delay
+}
@@ -0,0 +1,170 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+)
+
+const priceSchedulerSubject = "price-scheduler"
+
+type PriceScheduler struct {
+	itemRepo   output.ItemRepository
+	priceRepo  output.PriceRepository
+	transactor output.Transactor
+	notifier   output.ItemChangeNotifier
+	workerID   string
+	batchSize  int
+	interval   time.Duration
+	lease      time.Duration
+}
+
+func NewPriceScheduler(itemRepo output.ItemRepository, priceRepo output.PriceRepository, transactor output.Transactor, notifier output.ItemChangeNotifier, workerID string, batchSize int, interval, lease time.Duration) *PriceScheduler {
+	return &PriceScheduler{
+		itemRepo:   itemRepo,
+		priceRepo:  priceRepo,
+		transactor: transactor,
+		notifier:   notifier,
+		workerID:   workerID,
+		batchSize:  batchSize,
+		interval:   interval,
+		lease:      lease,
+	}
+}
+
+func (s *PriceScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	slog.InfoContext(ctx, "agendador de preços iniciado", "worker_id", s.workerID)
+
+	for {
+		for {
+			applied, err := s.ApplyBatch(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "falha ao processar agendamentos de preço", "error", err)
+				break
+			}
+			if applied < s.batchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("agendador de preços encerrado", "worker_id", s.workerID)
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *PriceScheduler) ApplyBatch(ctx context.Context) (int, error) {
+	schedules, err := s.priceRepo.ClaimDueSchedules(ctx, s.workerID, s.batchSize, s.lease)
+	if err != nil {
+		return 0, fmt.Errorf("erro ao obter agendamentos de preço pendentes: %w", err)
+	}
+
+	applied := 0
+	for _, schedule := range schedules {
+		settled, err := s.apply(ctx, schedule)
+		if err != nil {
+			slog.WarnContext(ctx, "falha ao aplicar agendamento de preço; nova tentativa após o lease",
+				"schedule_id", schedule.ID,
+				"item_id", schedule.ItemID,
+				"error", err,
+			)
+			continue
+		}
+		if settled {
+			applied++
+		}
+	}
+
+	return applied, nil
+}
+
+func (s *PriceScheduler) apply(ctx context.Context, claimed *domain.ScheduledPrice) (bool, error) {
+	ctx = domain.ContextWithPrincipal(ctx, &domain.Principal{
+		Subject:  priceSchedulerSubject,
+		SellerID: claimed.SellerID,
+	})
+
+	var changed *domain.Item
+	settled := false
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		changed, settled = nil, false
+
+		item, err := s.itemRepo.GetByID(ctx, claimed.ItemID)
+		if err != nil {
+			return fmt.Errorf("erro ao obter item: %w", err)
+		}
+
+		schedule, err := s.priceRepo.GetSchedule(ctx, claimed.ItemID, claimed.ID)
+		if err != nil {
+			return fmt.Errorf("erro ao obter agendamento de preço: %w", err)
+		}
+		if schedule == nil || !schedule.IsOpen() {
+			return nil
+		}
+
+		now := time.Now()
+
+		switch {
+		case item == nil:
+			schedule.Cancel()
+		case schedule.Status == domain.ScheduledPricePending && schedule.StartsAt.After(now):
+			return nil
+		case schedule.Status == domain.ScheduledPricePending && schedule.Expired(now):
+			schedule.Complete()
+		case schedule.Status == domain.ScheduledPricePending:
+			previousPrice := item.Price
+			item.ChangePrice(schedule.Price, domain.PriceChangeScheduled, &schedule.ID)
+			schedule.Activate(previousPrice)
+			if schedule.EndsAt == nil {
+				schedule.Complete()
+			}
+			if item.Price != previousPrice {
+				changed = item
+			}
+		case schedule.Expired(now):
+			if revertScheduledPrice(item, schedule) {
+				changed = item
+			}
+			schedule.Complete()
+		default:
+			return nil
+		}
+
+		if changed != nil {
+			if err := s.itemRepo.Update(ctx, changed); err != nil {
+				return fmt.Errorf("erro ao atualizar preço do item: %w", err)
+			}
+		}
+
+		if err := s.priceRepo.UpdateSchedule(ctx, schedule); err != nil {
+			return fmt.Errorf("erro ao atualizar agendamento de preço: %w", err)
+		}
+		settled = true
+
+		slog.InfoContext(ctx, "agendamento de preço processado",
+			"schedule_id", schedule.ID,
+			"item_id", schedule.ItemID,
+			"status", schedule.Status,
+		)
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if changed != nil && s.notifier != nil {
+		s.notifier.Notify(ctx, domain.NewItemChange(domain.EventItemUpdated, changed))
+	}
+
+	return settled, nil
+}
@@ -0,0 +1,347 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/output/authz"
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+	"github.com/fesbarbosa/melivendas-api/internal/core/services"
+)
+
+type memoryPriceRepository struct {
+	output.PriceRepository
+
+	mu        sync.Mutex
+	schedules map[int64]*domain.ScheduledPrice
+	claims    int
+}
+
+func newMemoryPriceRepository(schedules ...*domain.ScheduledPrice) *memoryPriceRepository {
+	r := &memoryPriceRepository{schedules: make(map[int64]*domain.ScheduledPrice)}
+	for _, schedule := range schedules {
+		r.schedules[schedule.ID] = schedule
+	}
+	return r
+}
+
+func (r *memoryPriceRepository) CreateSchedule(ctx context.Context, schedule *domain.ScheduledPrice) (*domain.ScheduledPrice, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	schedule.ID = int64(len(r.schedules) + 1)
+	r.schedules[schedule.ID] = schedule
+	return schedule, nil
+}
+
+func (r *memoryPriceRepository) GetSchedule(ctx context.Context, itemID, id int64) (*domain.ScheduledPrice, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if schedule, ok := r.schedules[id]; ok && schedule.ItemID == itemID {
+		copied := *schedule
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+func (r *memoryPriceRepository) FindSchedules(ctx context.Context, itemID int64) ([]*domain.ScheduledPrice, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var schedules []*domain.ScheduledPrice
+	for _, schedule := range r.schedules {
+		if schedule.ItemID == itemID {
+			copied := *schedule
+			schedules = append(schedules, &copied)
+		}
+	}
+	return schedules, nil
+}
+
+func (r *memoryPriceRepository) UpdateSchedule(ctx context.Context, schedule *domain.ScheduledPrice) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.schedules[schedule.ID] = schedule
+	return nil
+}
+
+func (r *memoryPriceRepository) ClaimDueSchedules(ctx context.Context, workerID string, limit int, lease time.Duration) ([]*domain.ScheduledPrice, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.claims++
+
+	var schedules []*domain.ScheduledPrice
+	for _, schedule := range r.schedules {
+		if schedule.IsOpen() {
+			copied := *schedule
+			schedules = append(schedules, &copied)
+		}
+	}
+	return schedules, nil
+}
+
+func (r *memoryPriceRepository) schedule(id int64) *domain.ScheduledPrice {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.schedules[id]
+}
+
+type failingItemRepository struct {
+	output.ItemRepository
+}
+
+func (failingItemRepository) GetByID(ctx context.Context, id int64) (*domain.Item, error) {
+	return nil, errors.New("conexão recusada")
+}
+
+func pricedItem(price int64) *domain.Item {
+	return &domain.Item{ID: 1, SellerID: "seller-1", Code: "ABC", Title: "Item", Description: "Descrição", Price: price, Stock: 5, Status: domain.ItemStatusActive}
+}
+
+func int64Ptr(value int64) *int64 {
+	return &value
+}
+
+func timePtr(value time.Time) *time.Time {
+	return &value
+}
+
+func TestPriceSchedulerApplyBatch(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		item        *domain.Item
+		schedule    *domain.ScheduledPrice
+		wantStatus  domain.ScheduledPriceStatus
+		wantPrice   int64
+		wantPrev    *int64
+		wantApplied int
+	}{
+		{
+			name:        "pendente com término é ativado",
+			item:        pricedItem(100),
+			schedule:    &domain.ScheduledPrice{ID: 1, ItemID: 1, Price: 80, StartsAt: now.Add(-time.Minute), EndsAt: timePtr(now.Add(time.Hour)), Status: domain.ScheduledPricePending},
+			wantStatus:  domain.ScheduledPriceActive,
+			wantPrice:   80,
+			wantPrev:    int64Ptr(100),
+			wantApplied: 1,
+		},
+		{
+			name:        "pendente sem término é aplicado e concluído",
+			item:        pricedItem(100),
+			schedule:    &domain.ScheduledPrice{ID: 1, ItemID: 1, Price: 80, StartsAt: now.Add(-time.Minute), Status: domain.ScheduledPricePending},
+			wantStatus:  domain.ScheduledPriceCompleted,
+			wantPrice:   80,
+			wantPrev:    int64Ptr(100),
+			wantApplied: 1,
+		},
+		{
+			name:        "pendente já expirado é concluído sem alterar o preço",
+			item:        pricedItem(100),
+			schedule:    &domain.ScheduledPrice{ID: 1, ItemID: 1, Price: 80, StartsAt: now.Add(-2 * time.Hour), EndsAt: timePtr(now.Add(-time.Hour)), Status: domain.ScheduledPricePending},
+			wantStatus:  domain.ScheduledPriceCompleted,
+			wantPrice:   100,
+			wantApplied: 1,
+		},
+		{
+			name:        "ativo expirado restaura o preço anterior",
+			item:        pricedItem(80),
+			schedule:    &domain.ScheduledPrice{ID: 1, ItemID: 1, Price: 80, StartsAt: now.Add(-2 * time.Hour), EndsAt: timePtr(now.Add(-time.Minute)), PreviousPrice: int64Ptr(100), Status: domain.ScheduledPriceActive},
+			wantStatus:  domain.ScheduledPriceCompleted,
+			wantPrice:   100,
+			wantPrev:    int64Ptr(100),
+			wantApplied: 1,
+		},
+		{
+			name:        "ativo expirado mantém preço alterado manualmente",
+			item:        pricedItem(90),
+			schedule:    &domain.ScheduledPrice{ID: 1, ItemID: 1, Price: 80, StartsAt: now.Add(-2 * time.Hour), EndsAt: timePtr(now.Add(-time.Minute)), PreviousPrice: int64Ptr(100), Status: domain.ScheduledPriceActive},
+			wantStatus:  domain.ScheduledPriceCompleted,
+			wantPrice:   90,
+			wantPrev:    int64Ptr(100),
+			wantApplied: 1,
+		},
+		{
+			name:        "item removido cancela o agendamento",
+			schedule:    &domain.ScheduledPrice{ID: 1, ItemID: 1, Price: 80, StartsAt: now.Add(-time.Minute), Status: domain.ScheduledPricePending},
+			wantStatus:  domain.ScheduledPriceCancelled,
+			wantApplied: 1,
+		},
+		{
+			name:       "pendente ainda não iniciado não é contado",
+			item:       pricedItem(100),
+			schedule:   &domain.ScheduledPrice{ID: 1, ItemID: 1, Price: 80, StartsAt: now.Add(time.Hour), Status: domain.ScheduledPricePending},
+			wantStatus: domain.ScheduledPricePending,
+			wantPrice:  100,
+		},
+		{
+			name:       "ativo ainda vigente não é contado",
+			item:       pricedItem(80),
+			schedule:   &domain.ScheduledPrice{ID: 1, ItemID: 1, Price: 80, StartsAt: now.Add(-time.Hour), EndsAt: timePtr(now.Add(time.Hour)), PreviousPrice: int64Ptr(100), Status: domain.ScheduledPriceActive},
+			wantStatus: domain.ScheduledPriceActive,
+			wantPrice:  80,
+			wantPrev:   int64Ptr(100),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itemRepo := newMemoryItemRepository()
+			if tt.item != nil {
+				itemRepo = newMemoryItemRepository(tt.item)
+			}
+			priceRepo := newMemoryPriceRepository(tt.schedule)
+			scheduler := services.NewPriceScheduler(itemRepo, priceRepo, passthroughTransactor{}, nil, "worker-1", 10, time.Minute, time.Minute)
+
+			applied, err := scheduler.ApplyBatch(context.Background())
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			if applied != tt.wantApplied {
+				t.Errorf("aplicados = %d, esperado %d", applied, tt.wantApplied)
+			}
+
+			schedule := priceRepo.schedule(tt.schedule.ID)
+			if schedule.Status != tt.wantStatus {
+				t.Errorf("status = %s, esperado %s", schedule.Status, tt.wantStatus)
+			}
+			if (schedule.PreviousPrice == nil) != (tt.wantPrev == nil) || (tt.wantPrev != nil && *schedule.PreviousPrice != *tt.wantPrev) {
+				t.Errorf("preço anterior = %v, esperado %v", schedule.PreviousPrice, tt.wantPrev)
+			}
+
+			if tt.item != nil {
+				item, _ := itemRepo.GetByID(context.Background(), tt.item.ID)
+				if item.Price != tt.wantPrice {
+					t.Errorf("preço do item = %d, esperado %d", item.Price, tt.wantPrice)
+				}
+			}
+		})
+	}
+}
+
+func TestPriceSchedulerRunDoesNotSpinOnUnsettledSchedules(t *testing.T) {
+	priceRepo := newMemoryPriceRepository(&domain.ScheduledPrice{ID: 1, ItemID: 1, Price: 80, StartsAt: time.Now().Add(-time.Minute), Status: domain.ScheduledPricePending})
+	scheduler := services.NewPriceScheduler(failingItemRepository{}, priceRepo, passthroughTransactor{}, nil, "worker-1", 1, time.Hour, time.Minute)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	scheduler.Run(ctx)
+
+	priceRepo.mu.Lock()
+	defer priceRepo.mu.Unlock()
+	if priceRepo.claims != 1 {
+		t.Fatalf("ClaimDueSchedules chamado %d vezes, esperado 1 até o próximo tick", priceRepo.claims)
+	}
+}
+
+func TestSchedulePriceRejectsOverlap(t *testing.T) {
+	now := time.Now()
+	start, end := now.Add(2*time.Hour), now.Add(4*time.Hour)
+
+	tests := []struct {
+		name     string
+		existing *domain.ScheduledPrice
+		wantErr  error
+	}{
+		{name: "sem agendamentos"},
+		{
+			name:     "pendente sobreposto",
+			existing: &domain.ScheduledPrice{ID: 7, ItemID: 1, Price: 70, StartsAt: now.Add(3 * time.Hour), EndsAt: timePtr(now.Add(5 * time.Hour)), Status: domain.ScheduledPricePending},
+			wantErr:  services.ErrScheduleOverlap,
+		},
+		{
+			name:     "ativo sem término",
+			existing: &domain.ScheduledPrice{ID: 7, ItemID: 1, Price: 70, StartsAt: now.Add(-time.Hour), Status: domain.ScheduledPriceActive},
+			wantErr:  services.ErrScheduleOverlap,
+		},
+		{
+			name:     "termina exatamente no início do novo",
+			existing: &domain.ScheduledPrice{ID: 7, ItemID: 1, Price: 70, StartsAt: now.Add(time.Hour), EndsAt: timePtr(start), Status: domain.ScheduledPricePending},
+		},
+		{
+			name:     "cancelado no mesmo período",
+			existing: &domain.ScheduledPrice{ID: 7, ItemID: 1, Price: 70, StartsAt: start, EndsAt: timePtr(end), Status: domain.ScheduledPriceCancelled},
+		},
+		{
+			name:     "concluído no mesmo período",
+			existing: &domain.ScheduledPrice{ID: 7, ItemID: 1, Price: 70, StartsAt: start, EndsAt: timePtr(end), Status: domain.ScheduledPriceCompleted},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			priceRepo := newMemoryPriceRepository()
+			if tt.existing != nil {
+				priceRepo = newMemoryPriceRepository(tt.existing)
+			}
+			service := services.NewPriceService(newMemoryItemRepository(pricedItem(100)), priceRepo, passthroughTransactor{}, authz.NewRolePolicy(), nil)
+
+			schedule, err := service.SchedulePrice(roleContext(domain.RoleAdmin), 1, 80, start, timePtr(end))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("esperava %v, obteve %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && schedule.Status != domain.ScheduledPricePending {
+				t.Fatalf("status = %s, esperado %s", schedule.Status, domain.ScheduledPricePending)
+			}
+		})
+	}
+}
+
+func TestCancelScheduledPrice(t *testing.T) {
+	now := time.Now()
+	active := func() *domain.ScheduledPrice {
+		return &domain.ScheduledPrice{ID: 3, ItemID: 1, Price: 80, StartsAt: now.Add(-time.Hour), EndsAt: timePtr(now.Add(time.Hour)), PreviousPrice: int64Ptr(100), Status: domain.ScheduledPriceActive}
+	}
+
+	tests := []struct {
+		name      string
+		itemPrice int64
+		schedule  *domain.ScheduledPrice
+		id        int64
+		wantErr   error
+		wantPrice int64
+	}{
+		{name: "ativo restaura o preço anterior", itemPrice: 80, schedule: active(), id: 3, wantPrice: 100},
+		{name: "ativo mantém preço alterado manualmente", itemPrice: 90, schedule: active(), id: 3, wantPrice: 90},
+		{
+			name:      "pendente não altera o preço",
+			itemPrice: 100,
+			schedule:  &domain.ScheduledPrice{ID: 3, ItemID: 1, Price: 80, StartsAt: now.Add(time.Hour), Status: domain.ScheduledPricePending},
+			id:        3,
+			wantPrice: 100,
+		},
+		{
+			name:      "concluído não pode ser cancelado",
+			itemPrice: 100,
+			schedule:  &domain.ScheduledPrice{ID: 3, ItemID: 1, Price: 80, StartsAt: now.Add(-2 * time.Hour), Status: domain.ScheduledPriceCompleted},
+			id:        3,
+			wantErr:   services.ErrScheduleNotCancellable,
+			wantPrice: 100,
+		},
+		{name: "inexistente", itemPrice: 80, schedule: active(), id: 99, wantErr: services.ErrScheduledPriceNotFound, wantPrice: 80},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itemRepo := newMemoryItemRepository(pricedItem(tt.itemPrice))
+			priceRepo := newMemoryPriceRepository(tt.schedule)
+			service := services.NewPriceService(itemRepo, priceRepo, passthroughTransactor{}, authz.NewRolePolicy(), nil)
+
+			schedule, err := service.CancelScheduledPrice(roleContext(domain.RoleAdmin), 1, tt.id)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("esperava %v, obteve %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && schedule.Status != domain.ScheduledPriceCancelled {
+				t.Fatalf("status = %s, esperado %s", schedule.Status, domain.ScheduledPriceCancelled)
+			}
+
+			item, _ := itemRepo.GetByID(context.Background(), 1)
+			if item.Price != tt.wantPrice {
+				t.Fatalf("preço do item = %d, esperado %d", item.Price, tt.wantPrice)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+)
+
+const maxPriceHistoryPage = 100
+
+var (
+	ErrScheduledPriceNotFound = errors.New("agendamento de preço não encontrado")
+
+	ErrInvalidScheduleData = errors.New("dados do agendamento de preço inválidos")
+
+	ErrScheduleStartInPast = fmt.Errorf("%w: início deve ser uma data futura", ErrInvalidScheduleData)
+
+	ErrScheduleEndBeforeStart = fmt.Errorf("%w: término deve ser posterior ao início", ErrInvalidScheduleData)
+
+	ErrScheduleNotCancellable = fmt.Errorf("%w: agendamento já concluído ou cancelado", ErrInvalidScheduleData)
+
+	ErrScheduleOverlap = errors.New("já existe um agendamento de preço neste período")
+)
+
+type PriceService struct {
+	itemRepo   output.ItemRepository
+	priceRepo  output.PriceRepository
+	transactor output.Transactor
+	policy     output.AuthorizationPolicy
+	notifier   output.ItemChangeNotifier
+}
+
+func NewPriceService(itemRepo output.ItemRepository, priceRepo output.PriceRepository, transactor output.Transactor, policy output.AuthorizationPolicy, notifier output.ItemChangeNotifier) *PriceService {
+	return &PriceService{
+		itemRepo:   itemRepo,
+		priceRepo:  priceRepo,
+		transactor: transactor,
+		policy:     policy,
+		notifier:   notifier,
+	}
+}
+
+func (s *PriceService) ListPriceHistory(ctx context.Context, itemID int64, limit int) ([]*domain.PriceHistoryEntry, error) {
+
+	if err := authorize(ctx, s.policy, domain.ActionItemRead); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getItem(ctx, itemID); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 || limit > maxPriceHistoryPage {
+		limit = maxPriceHistoryPage
+	}
+
+	history, err := s.priceRepo.FindHistory(ctx, itemID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao recuperar histórico de preços: %w", err)
+	}
+
+	return history, nil
+}
+
+func (s *PriceService) SchedulePrice(ctx context.Context, itemID, price int64, startsAt time.Time, endsAt *time.Time) (*domain.ScheduledPrice, error) {
+
+	if err := authorize(ctx, s.policy, domain.ActionItemUpdate); err != nil {
+		return nil, err
+	}
+
+	if price <= 0 {
+		return nil, ErrInvalidPrice
+	}
+
+	if !startsAt.After(time.Now()) {
+		return nil, ErrScheduleStartInPast
+	}
+
+	if endsAt != nil && !endsAt.After(startsAt) {
+		return nil, ErrScheduleEndBeforeStart
+	}
+
+	var saved *domain.ScheduledPrice
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := s.getItem(ctx, itemID); err != nil {
+			return err
+		}
+
+		schedules, err := s.priceRepo.FindSchedules(ctx, itemID)
+		if err != nil {
+			return fmt.Errorf("erro ao recuperar agendamentos de preço: %w", err)
+		}
+
+		for _, existing := range schedules {
+			if existing.IsOpen() && existing.Overlaps(startsAt, endsAt) {
+				return ErrScheduleOverlap
+			}
+		}
+
+		saved, err = s.priceRepo.CreateSchedule(ctx, domain.NewScheduledPrice(itemID, price, startsAt, endsAt))
+		if err != nil {
+			return fmt.Errorf("erro ao criar agendamento de preço: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "preço agendado", "item_id", itemID, "schedule_id", saved.ID, "price", price, "starts_at", startsAt)
+
+	return saved, nil
+}
+
+func (s *PriceService) ListScheduledPrices(ctx context.Context, itemID int64) ([]*domain.ScheduledPrice, error) {
+
+	if err := authorize(ctx, s.policy, domain.ActionItemRead); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getItem(ctx, itemID); err != nil {
+		return nil, err
+	}
+
+	schedules, err := s.priceRepo.FindSchedules(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao recuperar agendamentos de preço: %w", err)
+	}
+
+	return schedules, nil
+}
+
+func (s *PriceService) CancelScheduledPrice(ctx context.Context, itemID, id int64) (*domain.ScheduledPrice, error) {
+
+	if err := authorize(ctx, s.policy, domain.ActionItemUpdate); err != nil {
+		return nil, err
+	}
+
+	var schedule *domain.ScheduledPrice
+	var reverted *domain.Item
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		item, err := s.getItem(ctx, itemID)
+		if err != nil {
+			return err
+		}
+
+		schedule, err = s.priceRepo.GetSchedule(ctx, itemID, id)
+		if err != nil {
+			return fmt.Errorf("erro ao obter agendamento de preço: %w", err)
+		}
+
+		if schedule == nil {
+			return ErrScheduledPriceNotFound
+		}
+
+		if !schedule.IsOpen() {
+			return ErrScheduleNotCancellable
+		}
+
+		if schedule.Status == domain.ScheduledPriceActive && revertScheduledPrice(item, schedule) {
+			if err := s.itemRepo.Update(ctx, item); err != nil {
+				return fmt.Errorf("erro ao restaurar preço do item: %w", err)
+			}
+			reverted = item
+		}
+
+		schedule.Cancel()
+
+		if err := s.priceRepo.UpdateSchedule(ctx, schedule); err != nil {
+			return fmt.Errorf("erro ao cancelar agendamento de preço: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "agendamento de preço cancelado", "item_id", itemID, "schedule_id", id)
+
+	if reverted != nil && s.notifier != nil {
+		s.notifier.Notify(ctx, domain.NewItemChange(domain.EventItemUpdated, reverted))
+	}
+
+	return schedule, nil
+}
+
+func (s *PriceService) getItem(ctx context.Context, id int64) (*domain.Item, error) {
+	item, err := s.itemRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao obter item: %w", err)
+	}
+
+	if item == nil {
+		return nil, ErrItemNotFound
+	}
+
+	return item, nil
+}
+
+func revertScheduledPrice(item *domain.Item, schedule *domain.ScheduledPrice) bool {
+	if schedule.PreviousPrice == nil || item.Price != schedule.Price {
+		return false
+	}
+
+	item.ChangePrice(*schedule.PreviousPrice, domain.PriceChangeScheduleEnded, &schedule.ID)
+	return true
+}
@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+)
+
+type PricingService struct {
+	promotionRepo output.PromotionRepository
+}
+
+func NewPricingService(promotionRepo output.PromotionRepository) *PricingService {
+	return &PricingService{
+		promotionRepo: promotionRepo,
+	}
+}
+
+func (s *PricingService) PriceItem(ctx context.Context, item *domain.Item) (*domain.PricedItem, error) {
+	now := time.Now()
+
+	promotions, changedAt, err := s.promotions(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	priced := priceItem(*item, promotions, changedAt, now)
+	return &priced, nil
+}
+
+func (s *PricingService) PriceItems(ctx context.Context, items []domain.Item) ([]domain.PricedItem, error) {
+	priced := make([]domain.PricedItem, 0, len(items))
+	if len(items) == 0 {
+		return priced, nil
+	}
+
+	now := time.Now()
+
+	promotions, changedAt, err := s.promotions(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		priced = append(priced, priceItem(item, promotions, changedAt, now))
+	}
+
+	return priced, nil
+}
+
+func (s *PricingService) promotions(ctx context.Context, now time.Time) ([]*domain.Promotion, time.Time, error) {
+	promotions, err := s.promotionRepo.FindInEffect(ctx, now)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("erro ao recuperar promoções: %w", err)
+	}
+
+	changedAt, err := s.promotionRepo.LastChangedAt(ctx, now)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("erro ao recuperar promoções: %w", err)
+	}
+
+	return promotions, changedAt, nil
+}
+
+func priceItem(item domain.Item, promotions []*domain.Promotion, changedAt, now time.Time) domain.PricedItem {
+	priced := domain.PriceItem(item, promotions, now)
+	if changedAt.After(priced.PricingChangedAt) {
+		priced.PricingChangedAt = changedAt
+	}
+	return priced
+}
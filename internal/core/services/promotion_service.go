@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+)
+
+var (
+	ErrPromotionNotFound = errors.New("promoção não encontrada")
+
+	ErrInvalidPromotionData = errors.New("dados da promoção inválidos")
+
+	ErrMissingPromotionName = fmt.Errorf("%w: nome é obrigatório", ErrInvalidPromotionData)
+
+	ErrInvalidDiscountType = fmt.Errorf("%w: tipo de desconto deve ser PERCENTAGE ou FIXED", ErrInvalidPromotionData)
+
+	ErrInvalidDiscountValue = fmt.Errorf("%w: valor do desconto deve ser positivo e percentuais não podem passar de 100", ErrInvalidPromotionData)
+
+	ErrPromotionWithoutTargets = fmt.Errorf("%w: informe ao menos um item, categoria ou código", ErrInvalidPromotionData)
+
+	ErrPromotionEndBeforeStart = fmt.Errorf("%w: término deve ser posterior ao início", ErrInvalidPromotionData)
+
+	ErrPromotionInactive = fmt.Errorf("%w: promoção já desativada", ErrInvalidPromotionData)
+)
+
+type PromotionService struct {
+	repo   output.PromotionRepository
+	policy output.AuthorizationPolicy
+}
+
+func NewPromotionService(repo output.PromotionRepository, policy output.AuthorizationPolicy) *PromotionService {
+	return &PromotionService{
+		repo:   repo,
+		policy: policy,
+	}
+}
+
+func (s *PromotionService) CreatePromotion(ctx context.Context, name string, discountType domain.DiscountType, value int64, itemIDs []int64, categories, codes []string, priority int, stackable bool, startsAt time.Time, endsAt *time.Time) (*domain.Promotion, error) {
+
+	if err := authorize(ctx, s.policy, domain.ActionPromotionManage); err != nil {
+		return nil, err
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrMissingPromotionName
+	}
+
+	if !discountType.IsValid() {
+		return nil, ErrInvalidDiscountType
+	}
+
+	if value <= 0 || (discountType == domain.DiscountPercentage && value > 100) {
+		return nil, ErrInvalidDiscountValue
+	}
+
+	if len(itemIDs) == 0 && len(categories) == 0 && len(codes) == 0 {
+		return nil, ErrPromotionWithoutTargets
+	}
+
+	promotion := domain.NewPromotion(name, discountType, value, itemIDs, categories, codes, priority, stackable, startsAt, endsAt)
+
+	if promotion.EndsAt != nil && !promotion.EndsAt.After(promotion.StartsAt) {
+		return nil, ErrPromotionEndBeforeStart
+	}
+
+	saved, err := s.repo.Create(ctx, promotion)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao criar promoção: %w", err)
+	}
+
+	slog.InfoContext(ctx, "promoção criada", "promotion_id", saved.ID, "discount_type", saved.DiscountType, "value", saved.Value)
+
+	return saved, nil
+}
+
+func (s *PromotionService) GetPromotion(ctx context.Context, id int64) (*domain.Promotion, error) {
+
+	if err := authorize(ctx, s.policy, domain.ActionPromotionManage); err != nil {
+		return nil, err
+	}
+
+	return s.getPromotion(ctx, id)
+}
+
+func (s *PromotionService) ListPromotions(ctx context.Context) ([]*domain.Promotion, error) {
+
+	if err := authorize(ctx, s.policy, domain.ActionPromotionManage); err != nil {
+		return nil, err
+	}
+
+	promotions, err := s.repo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao recuperar promoções: %w", err)
+	}
+
+	return promotions, nil
+}
+
+func (s *PromotionService) DeactivatePromotion(ctx context.Context, id int64) (*domain.Promotion, error) {
+
+	if err := authorize(ctx, s.policy, domain.ActionPromotionManage); err != nil {
+		return nil, err
+	}
+
+	promotion, err := s.getPromotion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !promotion.Active {
+		return nil, ErrPromotionInactive
+	}
+
+	promotion.Deactivate()
+
+	if err := s.repo.Update(ctx, promotion); err != nil {
+		return nil, fmt.Errorf("erro ao desativar promoção: %w", err)
+	}
+
+	slog.InfoContext(ctx, "promoção desativada", "promotion_id", id)
+
+	return promotion, nil
+}
+
+func (s *PromotionService) getPromotion(ctx context.Context, id int64) (*domain.Promotion, error) {
+	promotion, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao obter promoção: %w", err)
+	}
+
+	if promotion == nil {
+		return nil, ErrPromotionNotFound
+	}
+
+	return promotion, nil
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+)
+
+const maxWebhookRetryDelay = time.Hour
+
+type WebhookDeliveryWorker struct {
+	repo         output.WebhookRepository
+	sender       output.WebhookSender
+	workerID     string
+	batchSize    int
+	interval     time.Duration
+	lease        time.Duration
+	maxAttempts  int
+	baseBackoff  time.Duration
+	disableAfter int
+}
+
+func NewWebhookDeliveryWorker(repo output.WebhookRepository, sender output.WebhookSender, workerID string, batchSize int, interval, lease time.Duration, maxAttempts int, baseBackoff time.Duration, disableAfter int) *WebhookDeliveryWorker {
+	return &WebhookDeliveryWorker{
+		repo:         repo,
+		sender:       sender,
+		workerID:     workerID,
+		batchSize:    batchSize,
+		interval:     interval,
+		lease:        lease,
+		maxAttempts:  maxAttempts,
+		baseBackoff:  baseBackoff,
+		disableAfter: disableAfter,
+	}
+}
+
+func (w *WebhookDeliveryWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	slog.InfoContext(ctx, "entregador de webhooks iniciado", "worker_id", w.workerID)
+
+	for {
+		if _, err := w.DeliverBatch(ctx); err != nil {
+			slog.ErrorContext(ctx, "falha ao processar entregas de webhook", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("entregador de webhooks encerrado", "worker_id", w.workerID)
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *WebhookDeliveryWorker) DeliverBatch(ctx context.Context) (int, error) {
+	jobs, err := w.repo.ClaimDueDeliveries(ctx, w.workerID, w.batchSize, w.lease)
+	if err != nil {
+		return 0, fmt.Errorf("erro ao obter entregas pendentes: %w", err)
+	}
+
+	delivered := 0
+	for _, job := range jobs {
+		if err := w.deliver(ctx, job); err != nil {
+			slog.WarnContext(ctx, "falha ao processar entrega de webhook; nova tentativa após o lease",
+				"delivery_id", job.Delivery.ID,
+				"webhook_id", job.Subscription.ID,
+				"error", err,
+			)
+			continue
+		}
+		delivered++
+	}
+
+	return delivered, nil
+}
+
+func (w *WebhookDeliveryWorker) deliver(ctx context.Context, job domain.WebhookDeliveryJob) error {
+	delivery := job.Delivery
+	subscription := job.Subscription
+
+	if !subscription.Active {
+		delivery.Status = domain.WebhookDeliveryFailed
+		delivery.LastError = "assinatura desativada"
+		return w.updateDelivery(ctx, delivery)
+	}
+
+	now := time.Now()
+	headers := map[string]string{
+		"Content-Type":         "application/json",
+		WebhookEventHeader:     string(delivery.EventType),
+		WebhookDeliveryHeader:  strconv.FormatInt(delivery.ID, 10),
+		WebhookSignatureHeader: SignWebhookPayload(subscription.Secret, now.Unix(), delivery.Payload),
+	}
+
+	statusCode, err := w.sender.Send(ctx, subscription.URL, headers, delivery.Payload)
+
+	delivery.Attempts++
+	delivery.ResponseCode = nil
+	if statusCode > 0 {
+		delivery.ResponseCode = &statusCode
+	}
+
+	if err == nil && statusCode >= 200 && statusCode < 300 {
+		delivery.Status = domain.WebhookDeliverySucceeded
+		delivery.LastError = ""
+		delivery.DeliveredAt = &now
+		subscription.RecordSuccess()
+	} else {
+		if err != nil {
+			delivery.LastError = err.Error()
+		} else {
+			delivery.LastError = fmt.Sprintf("resposta HTTP %d", statusCode)
+		}
+
+		if delivery.Attempts >= w.maxAttempts {
+			delivery.Status = domain.WebhookDeliveryFailed
+		} else {
+			delivery.NextAttemptAt = now.Add(w.retryDelay(delivery.Attempts))
+		}
+
+		subscription.RecordFailure(w.disableAfter)
+		if !subscription.Active {
+			slog.WarnContext(ctx, "assinatura de webhook desativada após falhas consecutivas",
+				"webhook_id", subscription.ID,
+				"failures", subscription.ConsecutiveFailures,
+			)
+		}
+	}
+
+	if err := w.repo.UpdateSubscriptionHealth(ctx, subscription); err != nil {
+		return fmt.Errorf("erro ao atualizar assinatura de webhook %d: %w", subscription.ID, err)
+	}
+
+	return w.updateDelivery(ctx, delivery)
+}
+
+func (w *WebhookDeliveryWorker) updateDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	if err := w.repo.UpdateDelivery(ctx, delivery); err != nil {
+		return fmt.Errorf("erro ao atualizar entrega de webhook %d: %w", delivery.ID, err)
+	}
+	return nil
+}
+
+func (w *WebhookDeliveryWorker) retryDelay(attempts int) time.Duration {
+	delay := w.baseBackoff << (attempts - 1)
+	if delay <= 0 || delay > maxWebhookRetryDelay {
+		return maxWebhookRetryDelay
+	}
+	return delay
+}
@@ -0,0 +1,182 @@
+package services_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/output/authz"
+	"github.com/fesbarbosa/melivendas-api/internal/adapters/output/webhook"
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+	"github.com/fesbarbosa/melivendas-api/internal/core/services"
+)
+
+type memoryWebhookRepository struct {
+	output.WebhookRepository
+
+	jobs       []domain.WebhookDeliveryJob
+	deliveries []*domain.WebhookDelivery
+
+	healthErrs map[int64]error
+}
+
+func (r *memoryWebhookRepository) CreateSubscription(ctx context.Context, subscription *domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	subscription.ID = 1
+	return subscription, nil
+}
+
+func (r *memoryWebhookRepository) ClaimDueDeliveries(ctx context.Context, workerID string, limit int, lease time.Duration) ([]domain.WebhookDeliveryJob, error) {
+	jobs := r.jobs
+	r.jobs = nil
+	return jobs, nil
+}
+
+func (r *memoryWebhookRepository) UpdateSubscriptionHealth(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	return r.healthErrs[subscription.ID]
+}
+
+func (r *memoryWebhookRepository) UpdateDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	r.deliveries = append(r.deliveries, delivery)
+	return nil
+}
+
+func TestWebhookDeliveryWorkerSignsAndDeliversPayload(t *testing.T) {
+	const secret = "segredo-de-teste"
+	payload := []byte(`{"event_type":"item.updated","item":{"id":1}}`)
+
+	type received struct {
+		header http.Header
+		body   []byte
+	}
+	requests := make(chan received, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- received{header: r.Header.Clone(), body: body}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	repo := &memoryWebhookRepository{jobs: []domain.WebhookDeliveryJob{{
+		Delivery:     &domain.WebhookDelivery{ID: 77, SubscriptionID: 5, EventType: domain.EventItemUpdated, Payload: payload, Status: domain.WebhookDeliveryPending},
+		Subscription: &domain.WebhookSubscription{ID: 5, URL: server.URL + "/hooks", Secret: secret, Active: true},
+	}}}
+
+	worker := services.NewWebhookDeliveryWorker(repo, webhook.NewHTTPSender(time.Second, true), "worker-1", 10, time.Second, time.Minute, 3, time.Second, 5)
+
+	delivered, err := worker.DeliverBatch(context.Background())
+	if err != nil || delivered != 1 {
+		t.Fatalf("entregas = %d, erro = %v", delivered, err)
+	}
+
+	req := <-requests
+	if string(req.body) != string(payload) {
+		t.Fatalf("corpo = %s, esperado %s", req.body, payload)
+	}
+	if got := req.header.Get(services.WebhookEventHeader); got != string(domain.EventItemUpdated) {
+		t.Errorf("%s = %q", services.WebhookEventHeader, got)
+	}
+	if got := req.header.Get(services.WebhookDeliveryHeader); got != "77" {
+		t.Errorf("%s = %q", services.WebhookDeliveryHeader, got)
+	}
+
+	signature := req.header.Get(services.WebhookSignatureHeader)
+	timestamp, digest, ok := strings.Cut(strings.TrimPrefix(signature, "t="), ",v1=")
+	if !ok {
+		t.Fatalf("assinatura malformada: %q", signature)
+	}
+	if _, err := strconv.ParseInt(timestamp, 10, 64); err != nil {
+		t.Fatalf("timestamp inválido na assinatura: %q", signature)
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+	if want := hex.EncodeToString(mac.Sum(nil)); !hmac.Equal([]byte(digest), []byte(want)) {
+		t.Fatalf("assinatura = %s, esperado %s", digest, want)
+	}
+
+	if len(repo.deliveries) != 1 {
+		t.Fatalf("esperava uma atualização de entrega, obteve %d", len(repo.deliveries))
+	}
+	delivery := repo.deliveries[0]
+	if delivery.Status != domain.WebhookDeliverySucceeded || delivery.ResponseCode == nil || *delivery.ResponseCode != http.StatusNoContent {
+		t.Fatalf("entrega = %+v", delivery)
+	}
+}
+
+func TestWebhookDeliveryWorkerContinuesAfterFailedJob(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	repo := &memoryWebhookRepository{
+		jobs: []domain.WebhookDeliveryJob{
+			{
+				Delivery:     &domain.WebhookDelivery{ID: 1, SubscriptionID: 5, EventType: domain.EventItemUpdated, Status: domain.WebhookDeliveryPending},
+				Subscription: &domain.WebhookSubscription{ID: 5, URL: server.URL, Secret: "a", Active: true},
+			},
+			{
+				Delivery:     &domain.WebhookDelivery{ID: 2, SubscriptionID: 6, EventType: domain.EventItemUpdated, Status: domain.WebhookDeliveryPending},
+				Subscription: &domain.WebhookSubscription{ID: 6, URL: server.URL, Secret: "b", Active: true},
+			},
+		},
+		healthErrs: map[int64]error{5: errors.New("conexão perdida")},
+	}
+
+	worker := services.NewWebhookDeliveryWorker(repo, webhook.NewHTTPSender(time.Second, true), "worker-1", 10, time.Second, time.Minute, 3, time.Second, 5)
+
+	delivered, err := worker.DeliverBatch(context.Background())
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if delivered != 1 {
+		t.Fatalf("entregas = %d, esperado 1", delivered)
+	}
+	if len(repo.deliveries) != 1 || repo.deliveries[0].ID != 2 {
+		t.Fatalf("esperava apenas a entrega 2 atualizada, obteve %+v", repo.deliveries)
+	}
+}
+
+func TestCreateSubscriptionRejectsInternalTargets(t *testing.T) {
+	tests := []struct {
+		url     string
+		allowed bool
+	}{
+		{url: "http://localhost:8080/hooks"},
+		{url: "http://api.localhost/hooks"},
+		{url: "http://127.0.0.1/hooks"},
+		{url: "http://169.254.169.254/latest/meta-data"},
+		{url: "http://[::1]/hooks"},
+		{url: "https://10.0.0.5/hooks"},
+		{url: "https://hooks.example.com/melivendas", allowed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			service := services.NewWebhookService(&memoryWebhookRepository{}, authz.NewRolePolicy(), false)
+
+			_, _, err := service.CreateSubscription(roleContext(domain.RoleAdmin), tt.url, []string{"*"}, "")
+			if tt.allowed {
+				if err != nil {
+					t.Fatalf("erro inesperado: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, services.ErrWebhookURLNotAllowed) {
+				t.Fatalf("esperava ErrWebhookURLNotAllowed, obteve %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+)
+
+type WebhookDispatcher struct {
+	repo output.WebhookRepository
+}
+
+func NewWebhookDispatcher(repo output.WebhookRepository) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		repo: repo,
+	}
+}
+
+func (d *WebhookDispatcher) Publish(ctx context.Context, event domain.ItemEvent) error {
+	subscriptions, err := d.repo.FindActiveSubscriptions(ctx, event.SellerID)
+	if err != nil {
+		return fmt.Errorf("erro ao recuperar assinaturas de webhook: %w", err)
+	}
+
+	var payload []byte
+	for _, subscription := range subscriptions {
+		if !subscription.Accepts(event.Type) {
+			continue
+		}
+
+		if payload == nil {
+			payload, err = json.Marshal(event)
+			if err != nil {
+				return fmt.Errorf("erro ao serializar evento %s: %w", event.ID, err)
+			}
+		}
+
+		delivery := domain.NewWebhookDelivery(subscription.ID, event.ID, event.Type, payload)
+		if _, err := d.repo.CreateDelivery(ctx, delivery); err != nil {
+			return fmt.Errorf("erro ao agendar entrega de webhook: %w", err)
+		}
+	}
+
+	return nil
+}
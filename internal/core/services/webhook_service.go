@@ -0,0 +1,227 @@
+package services
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/fesbarbosa/melivendas-api/internal/core/domain"
+	"github.com/fesbarbosa/melivendas-api/internal/core/ports/output"
+)
+
+const maxWebhookDeliveriesPage = 100
+
+var (
+	ErrWebhookNotFound = errors.New("assinatura de webhook não encontrada")
+
+	ErrWebhookDeliveryNotFound = errors.New("entrega de webhook não encontrada")
+
+	ErrInvalidWebhookData = errors.New("dados do webhook inválidos")
+
+	ErrInvalidWebhookURL = fmt.Errorf("%w: URL deve ser absoluta com esquema http ou https", ErrInvalidWebhookData)
+
+	ErrWebhookURLNotAllowed = fmt.Errorf("%w: URL aponta para um endereço de rede interna", ErrInvalidWebhookData)
+
+	ErrMissingEventTypes = fmt.Errorf("%w: ao menos um tipo de evento é obrigatório", ErrInvalidWebhookData)
+
+	ErrUnknownEventType = fmt.Errorf("%w: tipo de evento desconhecido", ErrInvalidWebhookData)
+
+	ErrSubscriptionDisabled = fmt.Errorf("%w: assinatura desativada; reative-a antes de reenviar", ErrInvalidWebhookData)
+)
+
+type WebhookService struct {
+	repo                 output.WebhookRepository
+	policy               output.AuthorizationPolicy
+	allowInternalTargets bool
+}
+
+func NewWebhookService(repo output.WebhookRepository, policy output.AuthorizationPolicy, allowInternalTargets bool) *WebhookService {
+	return &WebhookService{
+		repo:                 repo,
+		policy:               policy,
+		allowInternalTargets: allowInternalTargets,
+	}
+}
+
+func (s *WebhookService) CreateSubscription(ctx context.Context, rawURL string, eventTypes []string, secret string) (*domain.WebhookSubscription, string, error) {
+
+	if err := authorize(ctx, s.policy, domain.ActionWebhookManage); err != nil {
+		return nil, "", err
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, "", ErrInvalidWebhookURL
+	}
+
+	if !s.allowInternalTargets && isInternalHost(parsed.Hostname()) {
+		return nil, "", ErrWebhookURLNotAllowed
+	}
+
+	if len(eventTypes) == 0 {
+		return nil, "", ErrMissingEventTypes
+	}
+
+	for _, eventType := range eventTypes {
+		if eventType != "*" && !domain.EventType(eventType).IsValid() {
+			return nil, "", fmt.Errorf("%w %q", ErrUnknownEventType, eventType)
+		}
+	}
+
+	if secret == "" {
+		secret, err = randomString(32, hex.EncodeToString)
+		if err != nil {
+			return nil, "", fmt.Errorf("erro ao gerar segredo do webhook: %w", err)
+		}
+	}
+
+	subscription := domain.NewWebhookSubscription(parsed.String(), eventTypes, secret)
+
+	saved, err := s.repo.CreateSubscription(ctx, subscription)
+	if err != nil {
+		return nil, "", fmt.Errorf("erro ao criar assinatura de webhook: %w", err)
+	}
+
+	slog.InfoContext(ctx, "assinatura de webhook criada", "webhook_id", saved.ID, "url", saved.URL)
+
+	return saved, secret, nil
+}
+
+func (s *WebhookService) ListSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error) {
+
+	if err := authorize(ctx, s.policy, domain.ActionWebhookManage); err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := s.repo.FindSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao recuperar assinaturas de webhook: %w", err)
+	}
+
+	return subscriptions, nil
+}
+
+func (s *WebhookService) DeleteSubscription(ctx context.Context, id int64) error {
+
+	if err := authorize(ctx, s.policy, domain.ActionWebhookManage); err != nil {
+		return err
+	}
+
+	if _, err := s.getSubscription(ctx, id); err != nil {
+		return err
+	}
+
+	err := s.repo.DeleteSubscription(ctx, id)
+	if err != nil {
+		return fmt.Errorf("erro ao excluir assinatura de webhook: %w", err)
+	}
+
+	slog.InfoContext(ctx, "assinatura de webhook excluída", "webhook_id", id)
+
+	return nil
+}
+
+func (s *WebhookService) EnableSubscription(ctx context.Context, id int64) (*domain.WebhookSubscription, error) {
+
+	if err := authorize(ctx, s.policy, domain.ActionWebhookManage); err != nil {
+		return nil, err
+	}
+
+	subscription, err := s.getSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription.Enable()
+
+	err = s.repo.UpdateSubscriptionHealth(ctx, subscription)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao reativar assinatura de webhook: %w", err)
+	}
+
+	return subscription, nil
+}
+
+func (s *WebhookService) ListDeliveries(ctx context.Context, subscriptionID int64, limit int) ([]*domain.WebhookDelivery, error) {
+
+	if err := authorize(ctx, s.policy, domain.ActionWebhookManage); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getSubscription(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 || limit > maxWebhookDeliveriesPage {
+		limit = maxWebhookDeliveriesPage
+	}
+
+	deliveries, err := s.repo.FindDeliveries(ctx, subscriptionID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao recuperar entregas de webhook: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+func (s *WebhookService) Redeliver(ctx context.Context, subscriptionID, deliveryID int64) (*domain.WebhookDelivery, error) {
+
+	if err := authorize(ctx, s.policy, domain.ActionWebhookManage); err != nil {
+		return nil, err
+	}
+
+	subscription, err := s.getSubscription(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !subscription.Active {
+		return nil, ErrSubscriptionDisabled
+	}
+
+	delivery, err := s.repo.GetDelivery(ctx, subscriptionID, deliveryID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao obter entrega de webhook: %w", err)
+	}
+
+	if delivery == nil {
+		return nil, ErrWebhookDeliveryNotFound
+	}
+
+	redelivery, err := s.repo.CreateDelivery(ctx, delivery.Redelivery())
+	if err != nil {
+		return nil, fmt.Errorf("erro ao agendar reenvio do webhook: %w", err)
+	}
+
+	slog.InfoContext(ctx, "reenvio de webhook agendado", "webhook_id", subscriptionID, "delivery_id", deliveryID, "redelivery_id", redelivery.ID)
+
+	return redelivery, nil
+}
+
+func (s *WebhookService) getSubscription(ctx context.Context, id int64) (*domain.WebhookSubscription, error) {
+	subscription, err := s.repo.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao obter assinatura de webhook: %w", err)
+	}
+
+	if subscription == nil {
+		return nil, ErrWebhookNotFound
+	}
+
+	return subscription, nil
+}
+
+func isInternalHost(host string) bool {
+	host = strings.ToLower(host)
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+	return ip != nil && domain.IsInternalAddress(ip)
+}
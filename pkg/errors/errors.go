@@ -1,24 +1,93 @@
 package errors
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+
+	"github.com/fesbarbosa/melivendas-api/pkg/i18n"
+	"github.com/go-playground/validator/v10"
 )
 
 type APIError struct {
-	Status   int    `json:"status"`
+	Sucesso   bool         `json:"sucesso"`
+	Status    int          `json:"status"`
+	Codigo    string       `json:"codigo"`
+	Mensagem  string       `json:"mensagem"`
+	Campos    []FieldError `json:"campos,omitempty"`
+	RequestID string       `json:"request_id,omitempty"`
+
+	Params []interface{} `json:"-"`
+}
+
+type FieldError struct {
+	Campo    string `json:"campo"`
 	Codigo   string `json:"codigo"`
 	Mensagem string `json:"mensagem"`
+
+	Params []interface{} `json:"-"`
+}
+
+type Problem struct {
+	Type      string       `json:"type"`
+	Title     string       `json:"title"`
+	Status    int          `json:"status"`
+	Detail    string       `json:"detail"`
+	Instance  string       `json:"instance,omitempty"`
+	Codigo    string       `json:"codigo"`
+	Campos    []FieldError `json:"campos,omitempty"`
+	RequestID string       `json:"request_id,omitempty"`
 }
 
 var (
-	ErrNotFound       = errors.New("recurso não encontrado")
-	ErrBadRequest     = errors.New("requisição inválida")
-	ErrConflict       = errors.New("conflito com dados existentes")
-	ErrInternalServer = errors.New("erro interno do servidor")
+	ErrNotFound        = errors.New("recurso não encontrado")
+	ErrBadRequest      = errors.New("requisição inválida")
+	ErrConflict        = errors.New("conflito com dados existentes")
+	ErrUnauthorized    = errors.New("não autenticado")
+	ErrForbidden       = errors.New("acesso negado")
+	ErrTooManyRequests = errors.New("limite de requisições excedido")
+	ErrInternalServer  = errors.New("erro interno do servidor")
 )
 
+type kindError struct {
+	kind   error
+	err    error
+	code   string
+	params []interface{}
+}
+
+func (e *kindError) Error() string {
+	return e.err.Error()
+}
+
+func (e *kindError) Unwrap() []error {
+	return []error{e.kind, e.err}
+}
+
+func Wrap(kind, err error) error {
+	return &kindError{kind: kind, err: err}
+}
+
+func Coded(kind error, code string, params ...interface{}) error {
+	return &kindError{
+		kind:   kind,
+		err:    errors.New(i18n.Translate(i18n.Default, code, params...)),
+		code:   code,
+		params: params,
+	}
+}
+
+func NewFieldError(field, code string, params ...interface{}) FieldError {
+	return FieldError{
+		Campo:    field,
+		Codigo:   code,
+		Mensagem: i18n.Translate(i18n.Default, code, params...),
+		Params:   params,
+	}
+}
+
 func NewAPIError(err error) *APIError {
 	if err == nil {
 		return nil
@@ -26,6 +95,7 @@ func NewAPIError(err error) *APIError {
 
 	var status int
 	var code string
+	message := err.Error()
 
 	switch {
 	case errors.Is(err, ErrNotFound):
@@ -34,18 +104,117 @@ func NewAPIError(err error) *APIError {
 	case errors.Is(err, ErrBadRequest):
 		status = http.StatusBadRequest
 		code = "REQUISICAO_INVALIDA"
+	case errors.Is(err, ErrUnauthorized):
+		status = http.StatusUnauthorized
+		code = "NAO_AUTENTICADO"
+	case errors.Is(err, ErrForbidden):
+		status = http.StatusForbidden
+		code = "ACESSO_NEGADO"
+	case errors.Is(err, ErrTooManyRequests):
+		status = http.StatusTooManyRequests
+		code = "LIMITE_REQUISICOES_EXCEDIDO"
 	case errors.Is(err, ErrConflict):
 		status = http.StatusConflict
 		code = "CONFLITO"
 	default:
 		status = http.StatusInternalServerError
 		code = "ERRO_INTERNO_SERVIDOR"
+		message = ErrInternalServer.Error()
 	}
 
-	return &APIError{
+	apiErr := &APIError{
 		Status:   status,
 		Codigo:   code,
-		Mensagem: err.Error(),
+		Mensagem: message,
+	}
+
+	var coded *kindError
+	if errors.As(err, &coded) && coded.code != "" {
+		apiErr.Codigo = coded.code
+		apiErr.Mensagem = coded.err.Error()
+		apiErr.Params = coded.params
+	}
+
+	return apiErr
+}
+
+func NewValidationError(fields []FieldError) *APIError {
+	apiErr := NewAPIError(ErrBadRequest)
+	apiErr.Campos = fields
+	return apiErr
+}
+
+func NewBindingError(err error) *APIError {
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		fields := make([]FieldError, 0, len(validationErrors))
+		for _, fieldErr := range validationErrors {
+			fields = append(fields, validationFieldError(fieldErr))
+		}
+		return NewValidationError(fields)
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return NewValidationError([]FieldError{NewFieldError(typeErr.Field, "TIPO_INVALIDO", typeErr.Type.String())})
+	}
+
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return NewValidationError([]FieldError{NewFieldError("body", "JSON_INVALIDO")})
+	}
+
+	return NewValidationError([]FieldError{NewFieldError("body", "CORPO_INVALIDO")})
+}
+
+func fieldName(namespace string) string {
+	if _, field, found := strings.Cut(namespace, "."); found {
+		return field
+	}
+	return namespace
+}
+
+func validationFieldError(fieldErr validator.FieldError) FieldError {
+	field := fieldName(fieldErr.Namespace())
+
+	switch fieldErr.Tag() {
+	case "required":
+		return NewFieldError(field, "CAMPO_OBRIGATORIO")
+	case "gt":
+		return NewFieldError(field, "VALOR_MAIOR_QUE", fieldErr.Param())
+	case "gte":
+		return NewFieldError(field, "VALOR_MINIMO", fieldErr.Param())
+	case "min":
+		return NewFieldError(field, "TAMANHO_MINIMO", fieldErr.Param())
+	case "url":
+		return NewFieldError(field, "URL_INVALIDA")
+	default:
+		return NewFieldError(field, "REGRA_NAO_ATENDIDA", fieldErr.Tag())
+	}
+}
+
+func (e *APIError) Localize(lang i18n.Language) {
+	if i18n.Has(e.Codigo) {
+		e.Mensagem = i18n.Translate(lang, e.Codigo, e.Params...)
+	}
+
+	for i := range e.Campos {
+		if i18n.Has(e.Campos[i].Codigo) {
+			e.Campos[i].Mensagem = i18n.Translate(lang, e.Campos[i].Codigo, e.Campos[i].Params...)
+		}
+	}
+}
+
+func (e *APIError) Problem(instance string) *Problem {
+	return &Problem{
+		Type:      "urn:melivendas:erro:" + strings.ToLower(e.Codigo),
+		Title:     http.StatusText(e.Status),
+		Status:    e.Status,
+		Detail:    e.Mensagem,
+		Instance:  instance,
+		Codigo:    e.Codigo,
+		Campos:    e.Campos,
+		RequestID: e.RequestID,
 	}
 }
 
@@ -0,0 +1,109 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Language string
+
+const (
+	PortugueseBR Language = "pt-BR"
+	English      Language = "en"
+	Spanish      Language = "es"
+
+	Default = PortugueseBR
+)
+
+var catalogs = map[Language]map[string]string{
+	PortugueseBR: portugueseBR,
+	English:      english,
+	Spanish:      spanish,
+}
+
+type languageContextKey struct{}
+
+func Translate(lang Language, key string, args ...interface{}) string {
+	message, ok := catalogs[lang][key]
+	if !ok {
+		message, ok = catalogs[Default][key]
+	}
+	if !ok {
+		return key
+	}
+
+	if len(args) > 0 {
+		return fmt.Sprintf(message, args...)
+	}
+	return message
+}
+
+func Has(key string) bool {
+	_, ok := catalogs[Default][key]
+	return ok
+}
+
+func Negotiate(acceptLanguage string) Language {
+	type candidate struct {
+		tag     string
+		quality float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if tag == "" {
+			continue
+		}
+
+		quality := 1.0
+		if value, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		candidates = append(candidates, candidate{tag: strings.ToLower(tag), quality: quality})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].quality > candidates[j].quality
+	})
+
+	for _, c := range candidates {
+		if c.tag == "*" {
+			return Default
+		}
+
+		primary, _, _ := strings.Cut(c.tag, "-")
+		switch primary {
+		case "pt":
+			return PortugueseBR
+		case "en":
+			return English
+		case "es":
+			return Spanish
+		}
+	}
+
+	return Default
+}
+
+func ContextWithLanguage(ctx context.Context, lang Language) context.Context {
+	return context.WithValue(ctx, languageContextKey{}, lang)
+}
+
+func LanguageFromContext(ctx context.Context) Language {
+	if lang, ok := ctx.Value(languageContextKey{}).(Language); ok {
+		return lang
+	}
+	return Default
+}
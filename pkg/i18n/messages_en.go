@@ -0,0 +1,104 @@
+package i18n
+
+var english = map[string]string{
+	"NAO_ENCONTRADO":                     "resource not found",
+	"REQUISICAO_INVALIDA":                "invalid request",
+	"CONFLITO":                           "conflict with existing data",
+	"NAO_AUTENTICADO":                    "not authenticated",
+	"ACESSO_NEGADO":                      "operation not allowed for this user",
+	"LIMITE_REQUISICOES_EXCEDIDO":        "rate limit exceeded; try again in %d seconds",
+	"ERRO_INTERNO_SERVIDOR":              "internal server error",
+	"ROTA_NAO_ENCONTRADA":                "route not found",
+	"METODO_NAO_PERMITIDO":               "method not allowed for this route",
+	"MUTACAO_REQUER_POST":                "GraphQL mutations require the POST method",
+	"RESPOSTA_FORA_DA_ESPECIFICACAO":     "response does not match the API specification",
+	"ID_INVALIDO":                        "invalid ID",
+	"FILTRO_ID_INVALIDO":                 "invalid item ID in filter: %s",
+	"FILTRO_STATUS_INVALIDO":             "invalid status in filter: %s",
+	"LAST_EVENT_ID_INVALIDO":             "invalid Last-Event-ID",
+	"HISTORICO_EVENTOS_INDISPONIVEL":     "event history unavailable; reload the current state",
+	"TOKEN_AUSENTE":                      "missing access token",
+	"TOKEN_INVALIDO":                     "invalid token",
+	"ESQUEMA_AUTORIZACAO_INVALIDO":       "Authorization header must use the Bearer scheme",
+	"VENDEDOR_AUSENTE":                   "seller missing from request context",
+	"ITEM_NAO_ENCONTRADO":                "item not found",
+	"CODIGO_ITEM_DUPLICADO":              "an item with this code already exists",
+	"DADOS_ITEM_INVALIDOS":               "invalid item data",
+	"ITEM_CAMPOS_OBRIGATORIOS":           "code, title and description are required",
+	"PRECO_INVALIDO":                     "price must be greater than 0",
+	"ESTOQUE_NEGATIVO":                   "stock cannot be negative",
+	"CHAVE_API_NAO_ENCONTRADA":           "API key not found",
+	"CHAVE_API_INVALIDA":                 "invalid or expired API key",
+	"DADOS_CHAVE_API_INVALIDOS":          "invalid API key data",
+	"CHAVE_API_NOME_OBRIGATORIO":         "name is required",
+	"CHAVE_API_ESCOPO_OBRIGATORIO":       "at least one scope is required",
+	"CHAVE_API_ESCOPO_DESCONHECIDO":      "unknown scope",
+	"CHAVE_API_EXPIRACAO_INVALIDA":       "expiration date must be in the future",
+	"CHAVE_API_SOBREPOSICAO_NEGATIVA":    "overlap period cannot be negative",
+	"CHAVE_API_INATIVA":                  "only active keys can be rotated",
+	"CHAVE_API_JA_ROTACIONADA":           "API key already rotated; rotate the newest key instead",
+	"WEBHOOK_NAO_ENCONTRADO":             "webhook subscription not found",
+	"ENTREGA_WEBHOOK_NAO_ENCONTRADA":     "webhook delivery not found",
+	"DADOS_WEBHOOK_INVALIDOS":            "invalid webhook data",
+	"WEBHOOK_URL_INVALIDA":               "URL must be absolute with an http or https scheme",
+	"WEBHOOK_URL_NAO_PERMITIDA":          "URL points to an internal network address",
+	"WEBHOOK_EVENTOS_OBRIGATORIOS":       "at least one event type is required",
+	"WEBHOOK_EVENTO_DESCONHECIDO":        "unknown event type",
+	"WEBHOOK_DESATIVADO":                 "subscription disabled; reactivate it before redelivering",
+	"AGENDAMENTO_PRECO_NAO_ENCONTRADO":   "scheduled price not found",
+	"DADOS_AGENDAMENTO_PRECO_INVALIDOS":  "invalid scheduled price data",
+	"AGENDAMENTO_PRECO_INICIO_INVALIDO":  "start must be in the future",
+	"AGENDAMENTO_PRECO_TERMINO_INVALIDO": "end must be after start",
+	"AGENDAMENTO_PRECO_NAO_CANCELAVEL":   "scheduled price already completed or cancelled",
+	"AGENDAMENTO_PRECO_SOBREPOSTO":       "a scheduled price already exists in this period",
+	"PROMOCAO_NAO_ENCONTRADA":            "promotion not found",
+	"DADOS_PROMOCAO_INVALIDOS":           "invalid promotion data",
+	"PROMOCAO_NOME_OBRIGATORIO":          "promotion name is required",
+	"PROMOCAO_TIPO_DESCONTO_INVALIDO":    "discount type must be PERCENTAGE or FIXED",
+	"PROMOCAO_VALOR_DESCONTO_INVALIDO":   "discount value must be positive and percentages cannot exceed 100",
+	"PROMOCAO_SEM_ALVOS":                 "provide at least one item, category or code",
+	"PROMOCAO_TERMINO_INVALIDO":          "end must be after start",
+	"PROMOCAO_INATIVA":                   "promotion already deactivated",
+	"CAMPO_OBRIGATORIO":                  "required field",
+	"PARAMETRO_OBRIGATORIO":              "required parameter",
+	"CORPO_OBRIGATORIO":                  "request body is required",
+	"CORPO_INVALIDO":                     "invalid request body",
+	"FALHA_LEITURA_CORPO":                "failed to read request body",
+	"JSON_INVALIDO":                      "invalid JSON",
+	"CONTENT_TYPE_INVALIDO":              "must be application/json",
+	"TIPO_INVALIDO":                      "must be of type %s",
+	"VALOR_MAIOR_QUE":                    "must be greater than %s",
+	"VALOR_MINIMO":                       "must be greater than or equal to %s",
+	"TAMANHO_MINIMO":                     "must have at least %s",
+	"REGRA_NAO_ATENDIDA":                 "does not satisfy rule %s",
+	"NAO_NULO":                           "cannot be null",
+	"FORMATO_NAO_ACEITO":                 "does not match any of the accepted formats",
+	"DEVE_SER_OBJETO":                    "must be an object",
+	"DEVE_SER_LISTA":                     "must be a list",
+	"MINIMO_ELEMENTOS":                   "must have at least %d element(s)",
+	"DEVE_SER_TEXTO":                     "must be a string",
+	"NAO_VAZIO":                          "cannot be empty",
+	"MINIMO_CARACTERES":                  "must have at least %d characters",
+	"VALOR_FORA_DO_ENUM":                 "must be one of: %s",
+	"DATA_INVALIDA":                      "must be an RFC 3339 date",
+	"URL_INVALIDA":                       "must be a valid http(s) URL",
+	"DEVE_SER_NUMERICO":                  "must be numeric",
+	"DEVE_SER_INTEIRO":                   "must be an integer",
+	"DEVE_SER_BOOLEANO":                  "must be a boolean",
+	"STATUS_NAO_DOCUMENTADO":             "status %d is not documented",
+	"CONTENT_TYPE_NAO_DOCUMENTADO":       "content type %s is not documented",
+	"ITEM_CRIADO":                        "Item created successfully",
+	"ITEM_ATUALIZADO":                    "Item updated successfully",
+	"ITEM_EXCLUIDO":                      "Item deleted successfully",
+	"CHAVE_API_CRIADA":                   "API key created successfully; store the secret, it will not be shown again",
+	"CHAVE_API_REVOGADA":                 "API key revoked successfully",
+	"CHAVE_API_ROTACIONADA":              "API key rotated successfully",
+	"WEBHOOK_CRIADO":                     "Webhook created successfully; store the secret, it will not be shown again",
+	"WEBHOOK_EXCLUIDO":                   "Webhook deleted successfully",
+	"WEBHOOK_REATIVADO":                  "Webhook reactivated successfully",
+	"REENVIO_AGENDADO":                   "Redelivery scheduled successfully",
+	"PRECO_AGENDADO":                     "Price scheduled successfully",
+	"AGENDAMENTO_PRECO_CANCELADO":        "Scheduled price cancelled successfully",
+	"PROMOCAO_CRIADA":                    "Promotion created successfully",
+	"PROMOCAO_DESATIVADA":                "Promotion deactivated successfully",
+}
@@ -0,0 +1,104 @@
+package i18n
+
+var spanish = map[string]string{
+	"NAO_ENCONTRADO":                     "recurso no encontrado",
+	"REQUISICAO_INVALIDA":                "solicitud inválida",
+	"CONFLITO":                           "conflicto con datos existentes",
+	"NAO_AUTENTICADO":                    "no autenticado",
+	"ACESSO_NEGADO":                      "operación no permitida para el usuario",
+	"LIMITE_REQUISICOES_EXCEDIDO":        "límite de solicitudes excedido; inténtelo de nuevo en %d segundos",
+	"ERRO_INTERNO_SERVIDOR":              "error interno del servidor",
+	"ROTA_NAO_ENCONTRADA":                "ruta no encontrada",
+	"METODO_NAO_PERMITIDO":               "método no permitido para esta ruta",
+	"MUTACAO_REQUER_POST":                "las mutaciones GraphQL requieren el método POST",
+	"RESPOSTA_FORA_DA_ESPECIFICACAO":     "la respuesta no corresponde a la especificación de la API",
+	"ID_INVALIDO":                        "ID inválido",
+	"FILTRO_ID_INVALIDO":                 "ID de artículo inválido en el filtro: %s",
+	"FILTRO_STATUS_INVALIDO":             "estado inválido en el filtro: %s",
+	"LAST_EVENT_ID_INVALIDO":             "Last-Event-ID inválido",
+	"HISTORICO_EVENTOS_INDISPONIVEL":     "historial de eventos no disponible; recargue el estado",
+	"TOKEN_AUSENTE":                      "falta el token de acceso",
+	"TOKEN_INVALIDO":                     "token inválido",
+	"ESQUEMA_AUTORIZACAO_INVALIDO":       "el encabezado Authorization debe usar el esquema Bearer",
+	"VENDEDOR_AUSENTE":                   "vendedor ausente en el contexto de la solicitud",
+	"ITEM_NAO_ENCONTRADO":                "artículo no encontrado",
+	"CODIGO_ITEM_DUPLICADO":              "ya existe un artículo con este código",
+	"DADOS_ITEM_INVALIDOS":               "datos del artículo inválidos",
+	"ITEM_CAMPOS_OBRIGATORIOS":           "código, título y descripción son obligatorios",
+	"PRECO_INVALIDO":                     "el precio debe ser mayor que 0",
+	"ESTOQUE_NEGATIVO":                   "el stock no puede ser negativo",
+	"CHAVE_API_NAO_ENCONTRADA":           "clave de API no encontrada",
+	"CHAVE_API_INVALIDA":                 "clave de API inválida o expirada",
+	"DADOS_CHAVE_API_INVALIDOS":          "datos de la clave de API inválidos",
+	"CHAVE_API_NOME_OBRIGATORIO":         "el nombre es obligatorio",
+	"CHAVE_API_ESCOPO_OBRIGATORIO":       "se requiere al menos un alcance",
+	"CHAVE_API_ESCOPO_DESCONHECIDO":      "alcance desconocido",
+	"CHAVE_API_EXPIRACAO_INVALIDA":       "la fecha de expiración debe estar en el futuro",
+	"CHAVE_API_SOBREPOSICAO_NEGATIVA":    "el período de superposición no puede ser negativo",
+	"CHAVE_API_INATIVA":                  "solo se pueden rotar claves activas",
+	"CHAVE_API_JA_ROTACIONADA":           "la clave de API ya fue rotada; rote la clave más reciente",
+	"WEBHOOK_NAO_ENCONTRADO":             "suscripción de webhook no encontrada",
+	"ENTREGA_WEBHOOK_NAO_ENCONTRADA":     "entrega de webhook no encontrada",
+	"DADOS_WEBHOOK_INVALIDOS":            "datos del webhook inválidos",
+	"WEBHOOK_URL_INVALIDA":               "la URL debe ser absoluta con esquema http o https",
+	"WEBHOOK_URL_NAO_PERMITIDA":          "la URL apunta a una dirección de red interna",
+	"WEBHOOK_EVENTOS_OBRIGATORIOS":       "se requiere al menos un tipo de evento",
+	"WEBHOOK_EVENTO_DESCONHECIDO":        "tipo de evento desconocido",
+	"WEBHOOK_DESATIVADO":                 "suscripción desactivada; reactívela antes de reenviar",
+	"AGENDAMENTO_PRECO_NAO_ENCONTRADO":   "programación de precio no encontrada",
+	"DADOS_AGENDAMENTO_PRECO_INVALIDOS":  "datos de la programación de precio inválidos",
+	"AGENDAMENTO_PRECO_INICIO_INVALIDO":  "el inicio debe ser una fecha futura",
+	"AGENDAMENTO_PRECO_TERMINO_INVALIDO": "el fin debe ser posterior al inicio",
+	"AGENDAMENTO_PRECO_NAO_CANCELAVEL":   "programación ya concluida o cancelada",
+	"AGENDAMENTO_PRECO_SOBREPOSTO":       "ya existe una programación de precio en este período",
+	"PROMOCAO_NAO_ENCONTRADA":            "promoción no encontrada",
+	"DADOS_PROMOCAO_INVALIDOS":           "datos de la promoción inválidos",
+	"PROMOCAO_NOME_OBRIGATORIO":          "el nombre de la promoción es obligatorio",
+	"PROMOCAO_TIPO_DESCONTO_INVALIDO":    "el tipo de descuento debe ser PERCENTAGE o FIXED",
+	"PROMOCAO_VALOR_DESCONTO_INVALIDO":   "el valor del descuento debe ser positivo y los porcentajes no pueden superar 100",
+	"PROMOCAO_SEM_ALVOS":                 "informe al menos un ítem, categoría o código",
+	"PROMOCAO_TERMINO_INVALIDO":          "el fin debe ser posterior al inicio",
+	"PROMOCAO_INATIVA":                   "promoción ya desactivada",
+	"CAMPO_OBRIGATORIO":                  "campo obligatorio",
+	"PARAMETRO_OBRIGATORIO":              "parámetro obligatorio",
+	"CORPO_OBRIGATORIO":                  "el cuerpo de la solicitud es obligatorio",
+	"CORPO_INVALIDO":                     "cuerpo de la solicitud inválido",
+	"FALHA_LEITURA_CORPO":                "error al leer el cuerpo de la solicitud",
+	"JSON_INVALIDO":                      "JSON inválido",
+	"CONTENT_TYPE_INVALIDO":              "debe ser application/json",
+	"TIPO_INVALIDO":                      "debe ser del tipo %s",
+	"VALOR_MAIOR_QUE":                    "debe ser mayor que %s",
+	"VALOR_MINIMO":                       "debe ser mayor o igual a %s",
+	"TAMANHO_MINIMO":                     "debe tener como mínimo %s",
+	"REGRA_NAO_ATENDIDA":                 "no cumple la regla %s",
+	"NAO_NULO":                           "no puede ser nulo",
+	"FORMATO_NAO_ACEITO":                 "no corresponde a ninguno de los formatos aceptados",
+	"DEVE_SER_OBJETO":                    "debe ser un objeto",
+	"DEVE_SER_LISTA":                     "debe ser una lista",
+	"MINIMO_ELEMENTOS":                   "debe tener al menos %d elemento(s)",
+	"DEVE_SER_TEXTO":                     "debe ser un texto",
+	"NAO_VAZIO":                          "no puede estar vacío",
+	"MINIMO_CARACTERES":                  "debe tener al menos %d caracteres",
+	"VALOR_FORA_DO_ENUM":                 "debe ser uno de los valores: %s",
+	"DATA_INVALIDA":                      "debe ser una fecha en formato RFC 3339",
+	"URL_INVALIDA":                       "debe ser una URL http(s) válida",
+	"DEVE_SER_NUMERICO":                  "debe ser numérico",
+	"DEVE_SER_INTEIRO":                   "debe ser un número entero",
+	"DEVE_SER_BOOLEANO":                  "debe ser booleano",
+	"STATUS_NAO_DOCUMENTADO":             "estado %d no documentado",
+	"CONTENT_TYPE_NAO_DOCUMENTADO":       "tipo %s no documentado",
+	"ITEM_CRIADO":                        "Artículo creado con éxito",
+	"ITEM_ATUALIZADO":                    "Artículo actualizado con éxito",
+	"ITEM_EXCLUIDO":                      "Artículo eliminado con éxito",
+	"CHAVE_API_CRIADA":                   "Clave de API creada con éxito; guarde el secreto, no se volverá a mostrar",
+	"CHAVE_API_REVOGADA":                 "Clave de API revocada con éxito",
+	"CHAVE_API_ROTACIONADA":              "Clave de API rotada con éxito",
+	"WEBHOOK_CRIADO":                     "Webhook creado con éxito; guarde el secreto, no se volverá a mostrar",
+	"WEBHOOK_EXCLUIDO":                   "Webhook eliminado con éxito",
+	"WEBHOOK_REATIVADO":                  "Webhook reactivado con éxito",
+	"REENVIO_AGENDADO":                   "Reenvío programado con éxito",
+	"PRECO_AGENDADO":                     "Precio programado con éxito",
+	"AGENDAMENTO_PRECO_CANCELADO":        "Programación de precio cancelada con éxito",
+	"PROMOCAO_CRIADA":                    "Promoción creada con éxito",
+	"PROMOCAO_DESATIVADA":                "Promoción desactivada con éxito",
+}
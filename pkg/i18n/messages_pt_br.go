@@ -0,0 +1,104 @@
+package i18n
+
+var portugueseBR = map[string]string{
+	"NAO_ENCONTRADO":                     "recurso não encontrado",
+	"REQUISICAO_INVALIDA":                "requisição inválida",
+	"CONFLITO":                           "conflito com dados existentes",
+	"NAO_AUTENTICADO":                    "não autenticado",
+	"ACESSO_NEGADO":                      "operação não permitida para o usuário",
+	"LIMITE_REQUISICOES_EXCEDIDO":        "limite de requisições excedido; tente novamente em %d segundos",
+	"ERRO_INTERNO_SERVIDOR":              "erro interno do servidor",
+	"ROTA_NAO_ENCONTRADA":                "rota não encontrada",
+	"METODO_NAO_PERMITIDO":               "método não permitido para esta rota",
+	"MUTACAO_REQUER_POST":                "mutações GraphQL exigem o método POST",
+	"RESPOSTA_FORA_DA_ESPECIFICACAO":     "resposta não corresponde à especificação da API",
+	"ID_INVALIDO":                        "ID inválido",
+	"FILTRO_ID_INVALIDO":                 "ID de item inválido no filtro: %s",
+	"FILTRO_STATUS_INVALIDO":             "status inválido no filtro: %s",
+	"LAST_EVENT_ID_INVALIDO":             "Last-Event-ID inválido",
+	"HISTORICO_EVENTOS_INDISPONIVEL":     "histórico de eventos indisponível; recarregue o estado",
+	"TOKEN_AUSENTE":                      "token de acesso ausente",
+	"TOKEN_INVALIDO":                     "token inválido",
+	"ESQUEMA_AUTORIZACAO_INVALIDO":       "cabeçalho Authorization deve usar o esquema Bearer",
+	"VENDEDOR_AUSENTE":                   "vendedor ausente no contexto da requisição",
+	"ITEM_NAO_ENCONTRADO":                "item não encontrado",
+	"CODIGO_ITEM_DUPLICADO":              "um item com este código já existe",
+	"DADOS_ITEM_INVALIDOS":               "dados do item inválidos",
+	"ITEM_CAMPOS_OBRIGATORIOS":           "código, título e descrição são obrigatórios",
+	"PRECO_INVALIDO":                     "preço deve ser maior que 0",
+	"ESTOQUE_NEGATIVO":                   "estoque não pode ser negativo",
+	"CHAVE_API_NAO_ENCONTRADA":           "chave de API não encontrada",
+	"CHAVE_API_INVALIDA":                 "chave de API inválida ou expirada",
+	"DADOS_CHAVE_API_INVALIDOS":          "dados da chave de API inválidos",
+	"CHAVE_API_NOME_OBRIGATORIO":         "nome é obrigatório",
+	"CHAVE_API_ESCOPO_OBRIGATORIO":       "ao menos um escopo é obrigatório",
+	"CHAVE_API_ESCOPO_DESCONHECIDO":      "escopo desconhecido",
+	"CHAVE_API_EXPIRACAO_INVALIDA":       "data de expiração deve estar no futuro",
+	"CHAVE_API_SOBREPOSICAO_NEGATIVA":    "período de sobreposição não pode ser negativo",
+	"CHAVE_API_INATIVA":                  "apenas chaves ativas podem ser rotacionadas",
+	"CHAVE_API_JA_ROTACIONADA":           "chave de API já rotacionada; rotacione a chave mais recente",
+	"WEBHOOK_NAO_ENCONTRADO":             "assinatura de webhook não encontrada",
+	"ENTREGA_WEBHOOK_NAO_ENCONTRADA":     "entrega de webhook não encontrada",
+	"DADOS_WEBHOOK_INVALIDOS":            "dados do webhook inválidos",
+	"WEBHOOK_URL_INVALIDA":               "URL deve ser absoluta com esquema http ou https",
+	"WEBHOOK_URL_NAO_PERMITIDA":          "URL aponta para um endereço de rede interna",
+	"WEBHOOK_EVENTOS_OBRIGATORIOS":       "ao menos um tipo de evento é obrigatório",
+	"WEBHOOK_EVENTO_DESCONHECIDO":        "tipo de evento desconhecido",
+	"WEBHOOK_DESATIVADO":                 "assinatura desativada; reative-a antes de reenviar",
+	"AGENDAMENTO_PRECO_NAO_ENCONTRADO":   "agendamento de preço não encontrado",
+	"DADOS_AGENDAMENTO_PRECO_INVALIDOS":  "dados do agendamento de preço inválidos",
+	"AGENDAMENTO_PRECO_INICIO_INVALIDO":  "início deve ser uma data futura",
+	"AGENDAMENTO_PRECO_TERMINO_INVALIDO": "término deve ser posterior ao início",
+	"AGENDAMENTO_PRECO_NAO_CANCELAVEL":   "agendamento já concluído ou cancelado",
+	"AGENDAMENTO_PRECO_SOBREPOSTO":       "já existe um agendamento de preço neste período",
+	"PROMOCAO_NAO_ENCONTRADA":            "promoção não encontrada",
+	"DADOS_PROMOCAO_INVALIDOS":           "dados da promoção inválidos",
+	"PROMOCAO_NOME_OBRIGATORIO":          "nome da promoção é obrigatório",
+	"PROMOCAO_TIPO_DESCONTO_INVALIDO":    "tipo de desconto deve ser PERCENTAGE ou FIXED",
+	"PROMOCAO_VALOR_DESCONTO_INVALIDO":   "valor do desconto deve ser positivo e percentuais não podem passar de 100",
+	"PROMOCAO_SEM_ALVOS":                 "informe ao menos um item, categoria ou código",
+	"PROMOCAO_TERMINO_INVALIDO":          "término deve ser posterior ao início",
+	"PROMOCAO_INATIVA":                   "promoção já desativada",
+	"CAMPO_OBRIGATORIO":                  "campo obrigatório",
+	"PARAMETRO_OBRIGATORIO":              "parâmetro obrigatório",
+	"CORPO_OBRIGATORIO":                  "corpo da requisição é obrigatório",
+	"CORPO_INVALIDO":                     "corpo da requisição inválido",
+	"FALHA_LEITURA_CORPO":                "falha ao ler o corpo da requisição",
+	"JSON_INVALIDO":                      "JSON inválido",
+	"CONTENT_TYPE_INVALIDO":              "deve ser application/json",
+	"TIPO_INVALIDO":                      "deve ser do tipo %s",
+	"VALOR_MAIOR_QUE":                    "deve ser maior que %s",
+	"VALOR_MINIMO":                       "deve ser maior ou igual a %s",
+	"TAMANHO_MINIMO":                     "deve ter no mínimo %s",
+	"REGRA_NAO_ATENDIDA":                 "não atende à regra %s",
+	"NAO_NULO":                           "não pode ser nulo",
+	"FORMATO_NAO_ACEITO":                 "não corresponde a nenhum dos formatos aceitos",
+	"DEVE_SER_OBJETO":                    "deve ser um objeto",
+	"DEVE_SER_LISTA":                     "deve ser uma lista",
+	"MINIMO_ELEMENTOS":                   "deve ter pelo menos %d elemento(s)",
+	"DEVE_SER_TEXTO":                     "deve ser um texto",
+	"NAO_VAZIO":                          "não pode ser vazio",
+	"MINIMO_CARACTERES":                  "deve ter pelo menos %d caracteres",
+	"VALOR_FORA_DO_ENUM":                 "deve ser um dos valores: %s",
+	"DATA_INVALIDA":                      "deve ser uma data no formato RFC 3339",
+	"URL_INVALIDA":                       "deve ser uma URL http(s) válida",
+	"DEVE_SER_NUMERICO":                  "deve ser numérico",
+	"DEVE_SER_INTEIRO":                   "deve ser um número inteiro",
+	"DEVE_SER_BOOLEANO":                  "deve ser booleano",
+	"STATUS_NAO_DOCUMENTADO":             "status %d não documentado",
+	"CONTENT_TYPE_NAO_DOCUMENTADO":       "tipo %s não documentado",
+	"ITEM_CRIADO":                        "Item criado com sucesso",
+	"ITEM_ATUALIZADO":                    "Item atualizado com sucesso",
+	"ITEM_EXCLUIDO":                      "Item excluído com sucesso",
+	"CHAVE_API_CRIADA":                   "Chave de API criada com sucesso; guarde o segredo, ele não será exibido novamente",
+	"CHAVE_API_REVOGADA":                 "Chave de API revogada com sucesso",
+	"CHAVE_API_ROTACIONADA":              "Chave de API rotacionada com sucesso",
+	"WEBHOOK_CRIADO":                     "Webhook criado com sucesso; guarde o segredo, ele não será exibido novamente",
+	"WEBHOOK_EXCLUIDO":                   "Webhook excluído com sucesso",
+	"WEBHOOK_REATIVADO":                  "Webhook reativado com sucesso",
+	"REENVIO_AGENDADO":                   "Reenvio agendado com sucesso",
+	"PRECO_AGENDADO":                     "Preço agendado com sucesso",
+	"AGENDAMENTO_PRECO_CANCELADO":        "Agendamento de preço cancelado com sucesso",
+	"PROMOCAO_CRIADA":                    "Promoção criada com sucesso",
+	"PROMOCAO_DESATIVADA":                "Promoção desativada com sucesso",
+}